@@ -0,0 +1,242 @@
+// Package autoscaler scales self-hosted runners based on the number of
+// unclaimed and running tasks of their resource classes.
+package autoscaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TaskCounter reads the task counts of a resource class. circleci.Runner
+// satisfies this interface.
+type TaskCounter interface {
+	GetUnclaimedTaskCount(ctx context.Context, resourceClass string) (int, error)
+	GetRunningTaskCount(ctx context.Context, resourceClass string) (int, error)
+}
+
+// Scaler reads and changes the number of runner instances provisioned for a
+// resource class, e.g. the desired capacity of an auto scaling group.
+type Scaler interface {
+	Capacity(ctx context.Context, resourceClass string) (int, error)
+	SetCapacity(ctx context.Context, resourceClass string, capacity int) error
+}
+
+// Clock abstracts time so the controller can be driven by a fake clock in
+// tests.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+const DefaultInterval = 30 * time.Second
+
+var (
+	ErrRequiredTaskCounter     = errors.New("task counter is required")
+	ErrRequiredScaler          = errors.New("scaler is required")
+	ErrRequiredResourceClasses = errors.New("at least one resource class is required")
+	ErrInvalidCapacityBounds   = errors.New("min capacity must not be negative or greater than max capacity")
+)
+
+// ResourceClass configures how a single resource class is scaled.
+type ResourceClass struct {
+	Name string
+	Min  int
+	Max  int
+	// ScaleOutCooldown is the minimum time between a scaling event and a
+	// subsequent scale out.
+	ScaleOutCooldown time.Duration
+	// ScaleInCooldown is the minimum time between a scaling event and a
+	// subsequent scale in.
+	ScaleInCooldown time.Duration
+}
+
+type Config struct {
+	TaskCounter     TaskCounter
+	Scaler          Scaler
+	ResourceClasses []ResourceClass
+	// Interval is the time between two reconciliations. It defaults to
+	// DefaultInterval.
+	Interval time.Duration
+	// Clock defaults to the system clock.
+	Clock Clock
+	// OnError is called with errors met while running. Errors are otherwise
+	// ignored so that a failing resource class does not stop the others
+	// from being scaled.
+	OnError func(resourceClass string, err error)
+}
+
+// Controller periodically reconciles the capacity of each configured
+// resource class with its task counts.
+type Controller struct {
+	counter  TaskCounter
+	scaler   Scaler
+	classes  []ResourceClass
+	interval time.Duration
+	clock    Clock
+	onError  func(string, error)
+
+	mu         sync.Mutex
+	lastScaled map[string]time.Time
+}
+
+func NewController(cfg Config) (*Controller, error) {
+	if cfg.TaskCounter == nil {
+		return nil, ErrRequiredTaskCounter
+	}
+
+	if cfg.Scaler == nil {
+		return nil, ErrRequiredScaler
+	}
+
+	if len(cfg.ResourceClasses) == 0 {
+		return nil, ErrRequiredResourceClasses
+	}
+
+	for _, rc := range cfg.ResourceClasses {
+		if rc.Min < 0 || rc.Min > rc.Max {
+			return nil, fmt.Errorf("%s: %w", rc.Name, ErrInvalidCapacityBounds)
+		}
+	}
+
+	c := &Controller{
+		counter:    cfg.TaskCounter,
+		scaler:     cfg.Scaler,
+		classes:    cfg.ResourceClasses,
+		interval:   cfg.Interval,
+		clock:      cfg.Clock,
+		onError:    cfg.OnError,
+		lastScaled: make(map[string]time.Time),
+	}
+
+	if c.interval <= 0 {
+		c.interval = DefaultInterval
+	}
+
+	if c.clock == nil {
+		c.clock = realClock{}
+	}
+
+	return c, nil
+}
+
+// Run reconciles every interval until ctx is done.
+func (c *Controller) Run(ctx context.Context) error {
+	for {
+		c.Reconcile(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.clock.After(c.interval):
+		}
+	}
+}
+
+// Decision describes the outcome of reconciling one resource class.
+type Decision struct {
+	ResourceClass string
+	Unclaimed     int
+	Running       int
+	Current       int
+	Desired       int
+	// Scaled reports whether SetCapacity was called.
+	Scaled bool
+	Err    error
+}
+
+// Reconcile reconciles every resource class once and returns a decision for
+// each of them.
+func (c *Controller) Reconcile(ctx context.Context) []Decision {
+	decisions := make([]Decision, 0, len(c.classes))
+	for _, rc := range c.classes {
+		d := c.reconcile(ctx, rc)
+		if d.Err != nil && c.onError != nil {
+			c.onError(rc.Name, d.Err)
+		}
+		decisions = append(decisions, d)
+	}
+
+	return decisions
+}
+
+func (c *Controller) reconcile(ctx context.Context, rc ResourceClass) Decision {
+	d := Decision{ResourceClass: rc.Name}
+
+	d.Unclaimed, d.Err = c.counter.GetUnclaimedTaskCount(ctx, rc.Name)
+	if d.Err != nil {
+		return d
+	}
+
+	d.Running, d.Err = c.counter.GetRunningTaskCount(ctx, rc.Name)
+	if d.Err != nil {
+		return d
+	}
+
+	d.Current, d.Err = c.scaler.Capacity(ctx, rc.Name)
+	if d.Err != nil {
+		return d
+	}
+
+	d.Desired = DesiredCapacity(rc, d.Unclaimed, d.Running, d.Current)
+	if d.Desired == d.Current {
+		return d
+	}
+
+	now := c.clock.Now()
+	cooldown := rc.ScaleOutCooldown
+	if d.Desired < d.Current {
+		cooldown = rc.ScaleInCooldown
+	}
+
+	c.mu.Lock()
+	last, ok := c.lastScaled[rc.Name]
+	c.mu.Unlock()
+	if ok && now.Sub(last) < cooldown {
+		return d
+	}
+
+	if d.Err = c.scaler.SetCapacity(ctx, rc.Name, d.Desired); d.Err != nil {
+		return d
+	}
+	d.Scaled = true
+
+	c.mu.Lock()
+	c.lastScaled[rc.Name] = now
+	c.mu.Unlock()
+
+	return d
+}
+
+// DesiredCapacity returns the capacity needed to serve every running and
+// unclaimed task, bounded by rc.Min and rc.Max. Scaling in never goes below
+// the number of running tasks so that busy runners are not terminated.
+func DesiredCapacity(rc ResourceClass, unclaimed, running, current int) int {
+	desired := clamp(running+unclaimed, rc.Min, rc.Max)
+
+	if desired < current && desired < running {
+		desired = running
+		if desired > current {
+			desired = current
+		}
+	}
+
+	return desired
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
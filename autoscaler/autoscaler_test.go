@@ -0,0 +1,210 @@
+package autoscaler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grezar/go-circleci"
+)
+
+var _ TaskCounter = circleci.Runner(nil)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+	ch  chan time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, ch: make(chan time.Time)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	return c.ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+// Tick advances the clock and fires the pending After channel.
+func (c *fakeClock) Tick(d time.Duration) {
+	c.Advance(d)
+	c.ch <- c.Now()
+}
+
+type fakeTaskCounter struct {
+	unclaimed map[string]int
+	running   map[string]int
+	err       error
+}
+
+func (f *fakeTaskCounter) GetUnclaimedTaskCount(ctx context.Context, resourceClass string) (int, error) {
+	return f.unclaimed[resourceClass], f.err
+}
+
+func (f *fakeTaskCounter) GetRunningTaskCount(ctx context.Context, resourceClass string) (int, error) {
+	return f.running[resourceClass], f.err
+}
+
+type fakeScaler struct {
+	mu       sync.Mutex
+	capacity map[string]int
+	calls    []int
+}
+
+func (f *fakeScaler) Capacity(ctx context.Context, resourceClass string) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.capacity[resourceClass], nil
+}
+
+func (f *fakeScaler) SetCapacity(ctx context.Context, resourceClass string, capacity int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.capacity[resourceClass] = capacity
+	f.calls = append(f.calls, capacity)
+	return nil
+}
+
+func TestNewController(t *testing.T) {
+	_, err := NewController(Config{
+		TaskCounter:     &fakeTaskCounter{},
+		Scaler:          &fakeScaler{},
+		ResourceClasses: []ResourceClass{{Name: "ns/rc", Min: 3, Max: 1}},
+	})
+	if !errors.Is(err, ErrInvalidCapacityBounds) {
+		t.Errorf("NewController got error %v, want %v", err, ErrInvalidCapacityBounds)
+	}
+}
+
+func TestDesiredCapacity(t *testing.T) {
+	rc := ResourceClass{Name: "ns/rc", Min: 1, Max: 5}
+
+	tests := []struct {
+		name                        string
+		unclaimed, running, current int
+		want                        int
+	}{
+		{name: "idle", current: 3, want: 1},
+		{name: "scale out", unclaimed: 2, running: 1, current: 1, want: 3},
+		{name: "bounded by max", unclaimed: 10, running: 1, current: 1, want: 5},
+		{name: "steady", unclaimed: 0, running: 2, current: 2, want: 2},
+		{name: "busy runners are kept", unclaimed: 0, running: 7, current: 6, want: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DesiredCapacity(rc, tt.unclaimed, tt.running, tt.current); got != tt.want {
+				t.Errorf("DesiredCapacity got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestController_Reconcile(t *testing.T) {
+	clock := newFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	counter := &fakeTaskCounter{
+		unclaimed: map[string]int{"ns/rc": 4},
+		running:   map[string]int{"ns/rc": 0},
+	}
+	scaler := &fakeScaler{capacity: map[string]int{"ns/rc": 0}}
+
+	c, err := NewController(Config{
+		TaskCounter: counter,
+		Scaler:      scaler,
+		ResourceClasses: []ResourceClass{{
+			Name:             "ns/rc",
+			Min:              0,
+			Max:              3,
+			ScaleOutCooldown: time.Minute,
+			ScaleInCooldown:  10 * time.Minute,
+		}},
+		Clock: clock,
+	})
+	if err != nil {
+		t.Fatalf("NewController got error: %v", err)
+	}
+
+	ctx := context.Background()
+
+	got := c.Reconcile(ctx)
+	want := []Decision{{ResourceClass: "ns/rc", Unclaimed: 4, Current: 0, Desired: 3, Scaled: true}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Reconcile got %+v, want %+v", got, want)
+	}
+
+	// The queue drains but scale in is still cooling down.
+	counter.unclaimed["ns/rc"] = 0
+	counter.running["ns/rc"] = 1
+	clock.Advance(5 * time.Minute)
+	got = c.Reconcile(ctx)
+	want = []Decision{{ResourceClass: "ns/rc", Running: 1, Current: 3, Desired: 1}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Reconcile got %+v, want %+v", got, want)
+	}
+
+	clock.Advance(5 * time.Minute)
+	got = c.Reconcile(ctx)
+	want = []Decision{{ResourceClass: "ns/rc", Running: 1, Current: 3, Desired: 1, Scaled: true}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Reconcile got %+v, want %+v", got, want)
+	}
+
+	if want := []int{3, 1}; !cmp.Equal(scaler.calls, want) {
+		t.Errorf("SetCapacity calls got %v, want %v", scaler.calls, want)
+	}
+}
+
+func TestController_Run(t *testing.T) {
+	clock := newFakeClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	errCount := errors.New("boom")
+	counter := &fakeTaskCounter{err: errCount}
+	scaler := &fakeScaler{capacity: map[string]int{}}
+
+	errs := make(chan error, 2)
+	c, err := NewController(Config{
+		TaskCounter:     counter,
+		Scaler:          scaler,
+		ResourceClasses: []ResourceClass{{Name: "ns/rc", Max: 1}},
+		Clock:           clock,
+		OnError: func(resourceClass string, err error) {
+			errs <- err
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewController got error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- c.Run(ctx)
+	}()
+
+	if err := <-errs; err != errCount {
+		t.Errorf("OnError got %v, want %v", err, errCount)
+	}
+
+	clock.Tick(DefaultInterval)
+	if err := <-errs; err != errCount {
+		t.Errorf("OnError got %v, want %v", err, errCount)
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run got %v, want %v", err, context.Canceled)
+	}
+}
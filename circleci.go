@@ -1,7 +1,9 @@
 package circleci
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -20,6 +22,12 @@ const (
 
 	DefaultAddress  = "https://circleci.com"
 	DefaultBasePath = "/api/v2/"
+
+	DefaultRunnerAddress  = "https://runner.circleci.com"
+	DefaultRunnerBasePath = "/api/v3/"
+
+	// graphQLPath is where the GraphQL API is served, relative to Address.
+	graphQLPath = "/graphql-unstable"
 )
 
 type Config struct {
@@ -28,15 +36,22 @@ type Config struct {
 	Token      string
 	Headers    http.Header
 	HTTPClient *http.Client
+
+	// RunnerAddress and RunnerBasePath locate the self-hosted runner API,
+	// which is served separately from the v2 API.
+	RunnerAddress  string
+	RunnerBasePath string
 }
 
 func DefaultConfig() *Config {
 	config := &Config{
-		Address:    DefaultAddress,
-		BasePath:   DefaultBasePath,
-		Token:      os.Getenv("CIRCLECI_TOKEN"),
-		Headers:    make(http.Header),
-		HTTPClient: &http.Client{},
+		Address:        DefaultAddress,
+		BasePath:       DefaultBasePath,
+		Token:          os.Getenv("CIRCLECI_TOKEN"),
+		Headers:        make(http.Header),
+		HTTPClient:     &http.Client{},
+		RunnerAddress:  DefaultRunnerAddress,
+		RunnerBasePath: DefaultRunnerBasePath,
 	}
 
 	config.Headers.Set("User-Agent", userAgent)
@@ -45,10 +60,12 @@ func DefaultConfig() *Config {
 }
 
 type Client struct {
-	baseURL *url.URL
-	token   string
-	headers http.Header
-	http    *http.Client
+	baseURL       *url.URL
+	runnerBaseURL *url.URL
+	graphQLURL    *url.URL
+	token         string
+	headers       http.Header
+	http          *http.Client
 
 	Contexts  Contexts
 	Projects  Projects
@@ -58,6 +75,16 @@ type Client struct {
 	Jobs      Jobs
 	Insights  Insights
 	Webhooks  Webhooks
+	OIDC      OIDC
+	Policies  Policies
+	Usage     Usage
+	Runner    Runner
+	Deploys   Deploys
+	Orbs      Orbs
+	Configs   Configs
+
+	Organizations       Organizations
+	PipelineDefinitions PipelineDefinitions
 }
 
 func NewClient(cfg *Config) (*Client, error) {
@@ -79,6 +106,12 @@ func NewClient(cfg *Config) (*Client, error) {
 		if cfg.HTTPClient != nil {
 			config.HTTPClient = cfg.HTTPClient
 		}
+		if cfg.RunnerAddress != "" {
+			config.RunnerAddress = cfg.RunnerAddress
+		}
+		if cfg.RunnerBasePath != "" {
+			config.RunnerBasePath = cfg.RunnerBasePath
+		}
 	}
 
 	baseURL, err := url.Parse(config.Address)
@@ -91,15 +124,33 @@ func NewClient(cfg *Config) (*Client, error) {
 		baseURL.Path += "/"
 	}
 
+	runnerBaseURL, err := url.Parse(config.RunnerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid runner address: %v", err)
+	}
+
+	runnerBaseURL.Path = config.RunnerBasePath
+	if !strings.HasSuffix(runnerBaseURL.Path, "/") {
+		runnerBaseURL.Path += "/"
+	}
+
+	graphQLURL, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %v", err)
+	}
+	graphQLURL.Path = graphQLPath
+
 	if config.Token == "" {
 		return nil, fmt.Errorf("API token is required")
 	}
 
 	client := &Client{
-		baseURL: baseURL,
-		token:   config.Token,
-		headers: config.Headers,
-		http:    config.HTTPClient,
+		baseURL:       baseURL,
+		runnerBaseURL: runnerBaseURL,
+		graphQLURL:    graphQLURL,
+		token:         config.Token,
+		headers:       config.Headers,
+		http:          config.HTTPClient,
 	}
 
 	client.Contexts = &contexts{client: client}
@@ -110,12 +161,108 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.Jobs = &jobs{client: client}
 	client.Insights = &insights{client: client}
 	client.Webhooks = &webhooks{client: client}
+	client.OIDC = &oidc{client: client}
+	client.Policies = &policies{client: client}
+	client.Usage = &usage{client: client}
+	client.Runner = &runner{client: client}
+	client.PipelineDefinitions = &pipelineDefinitions{client: client}
+	client.Organizations = &organizations{client: client}
+	client.Deploys = &deploys{client: client}
+	client.Orbs = &orbs{client: client}
+	client.Configs = &configs{client: client}
 
 	return client, nil
 }
 
+// v1Path returns the path of the given v1.1 API endpoint relative to the
+// configured v2 base path, e.g. /api/v2/ resolves it under /api/v1.1/.
+func v1Path(path string) string {
+	return "../v1.1/" + path
+}
+
+// v1ProjectSlug converts a v2 project slug such as gh/org/repo into the form
+// expected by the v1.1 API, which spells out the VCS type.
+func v1ProjectSlug(projectSlug string) string {
+	parts := strings.SplitN(projectSlug, "/", 2)
+	if len(parts) != 2 {
+		return projectSlug
+	}
+
+	switch parts[0] {
+	case "gh":
+		parts[0] = "github"
+	case "bb":
+		parts[0] = "bitbucket"
+	}
+
+	return parts[0] + "/" + parts[1]
+}
+
 func (c *Client) newRequest(method string, path string, v interface{}) (*http.Request, error) {
-	u, err := c.baseURL.Parse(path)
+	return c.newRequestWithBaseURL(c.baseURL, method, path, v)
+}
+
+// newRunnerRequest is like newRequest but for the self-hosted runner API.
+func (c *Client) newRunnerRequest(method string, path string, v interface{}) (*http.Request, error) {
+	return c.newRequestWithBaseURL(c.runnerBaseURL, method, path, v)
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+type GraphQLError struct {
+	Message string `json:"message"`
+}
+
+// GraphQLErrors is returned when the GraphQL API answers a query with errors.
+type GraphQLErrors []*GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// doGraphQL runs query against the GraphQL API and decodes its data into v.
+func (c *Client) doGraphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) error {
+	req, err := c.newRequestWithBaseURL(c.graphQLURL, "POST", "", &graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return err
+	}
+	// The GraphQL API reads the token from the Authorization header.
+	req.Header.Set("Authorization", c.token)
+
+	resp := &graphQLResponse{}
+	err = c.do(ctx, req, resp)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+
+	if v == nil || len(resp.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(resp.Data, v)
+}
+
+func (c *Client) newRequestWithBaseURL(baseURL *url.URL, method string, path string, v interface{}) (*http.Request, error) {
+	u, err := baseURL.Parse(path)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +352,53 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) error
 	return err
 }
 
+// openExternal fetches a pre-signed URL returned by the API, such as a file
+// download or step output URL. The API token is deliberately not sent along.
+// The returned body is decompressed when it is served gzipped.
+func (c *Client) openExternal(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResponseCode(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	br := bufio.NewReader(resp.Body)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &externalBody{Reader: gr, closers: []io.Closer{gr, resp.Body}}, nil
+	}
+
+	return &externalBody{Reader: br, closers: []io.Closer{resp.Body}}, nil
+}
+
+type externalBody struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (b *externalBody) Close() error {
+	var err error
+	for _, c := range b.closers {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
 type ErrorResponse struct {
 	Message string `json:"message"`
 }
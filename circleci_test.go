@@ -27,6 +27,8 @@ func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown fun
 		panic(fmt.Sprintf("failed to initialize client, error: %v", err))
 	}
 	client.baseURL = url
+	client.runnerBaseURL = url
+	client.graphQLURL, _ = url.Parse(graphQLPath)
 
 	return client, mux, server.URL, server.Close
 }
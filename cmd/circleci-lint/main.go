@@ -0,0 +1,44 @@
+// Command circleci-lint checks CircleCI config files for structural errors
+// without contacting CircleCI.
+//
+// Usage:
+//
+//	circleci-lint [file ...]
+//
+// It checks .circleci/config.yml when no file is given, prints one problem
+// per line and exits with status 1 when any problem is found.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/grezar/go-circleci/config"
+)
+
+const defaultConfigPath = ".circleci/config.yml"
+
+func main() {
+	paths := os.Args[1:]
+	if len(paths) == 0 {
+		paths = []string{defaultConfigPath}
+	}
+
+	failed := false
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+
+		for _, p := range config.Lint(path, src) {
+			fmt.Println(p)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,66 @@
+// Command circleci-webhook sends sample CircleCI webhook events, signed like
+// CircleCI signs them, to a receiver under test.
+//
+// Usage:
+//
+//	circleci-webhook -secret s3cr3t [flags] url
+//
+// Without a url, the signed payload is printed along with its signature
+// header instead of being sent.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/grezar/go-circleci"
+	"github.com/grezar/go-circleci/webhook"
+	"github.com/grezar/go-circleci/webhook/webhooktest"
+)
+
+func main() {
+	var o webhooktest.EventOptions
+	eventType := flag.String("type", string(circleci.EventWorkflowCompleted), "event type, workflow-completed or job-completed")
+	secret := flag.String("secret", os.Getenv("CIRCLECI_WEBHOOK_SECRET"), "signing secret, defaults to $CIRCLECI_WEBHOOK_SECRET")
+	flag.StringVar(&o.Project, "project", "", "project slug")
+	flag.StringVar(&o.Branch, "branch", "", "branch")
+	flag.StringVar(&o.Status, "status", "", "workflow and job status")
+	flag.StringVar(&o.Workflow, "workflow", "", "workflow name")
+	flag.StringVar(&o.Job, "job", "", "job name")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s -secret secret [flags] [url]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *secret == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	o.Type = circleci.Event(*eventType)
+
+	e, err := webhooktest.NewEvent(o)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if flag.NArg() == 0 {
+		body, err := json.MarshalIndent(e, "", "  ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Printf("%s: %s\n%s\n", webhook.SignatureHeader, webhook.Sign(body, *secret), body)
+		return
+	}
+
+	if err := webhooktest.Send(context.Background(), nil, flag.Arg(0), e, *secret); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("sent %s event %s\n", e.EventType(), e.EventID())
+}
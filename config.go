@@ -0,0 +1,154 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Configs compiles and validates config YAML server-side, the same way
+// CircleCI processes it when a pipeline is triggered or continued.
+type Configs interface {
+	Compile(ctx context.Context, options ConfigCompileOptions) (*ConfigCompilation, error)
+	Validate(ctx context.Context, options ConfigCompileOptions) error
+}
+
+// configs implements Configs interface
+type configs struct {
+	client *Client
+}
+
+type ConfigCompileOptions struct {
+	Config *string
+	// OwnerID is the ID of the organization the config is compiled for. It
+	// is needed to resolve private orbs.
+	OwnerID            *string
+	PipelineParameters map[string]interface{}
+	// PipelineValues sets values such as "pipeline.git.branch".
+	PipelineValues map[string]interface{}
+}
+
+func (o ConfigCompileOptions) valid() error {
+	if !validString(o.Config) {
+		return ErrRequiredConfigYAML
+	}
+
+	return nil
+}
+
+type configCompileRequest struct {
+	ConfigYAML string                     `json:"config_yaml"`
+	Options    configCompileRequestOption `json:"options"`
+}
+
+type configCompileRequestOption struct {
+	OwnerID string `json:"owner_id,omitempty"`
+	// PipelineParameters is sent as an encoded document rather than an
+	// object.
+	PipelineParameters string                 `json:"pipeline_parameters,omitempty"`
+	PipelineValues     map[string]interface{} `json:"pipeline_values,omitempty"`
+}
+
+type configCompileResponse struct {
+	Valid      bool         `json:"valid"`
+	SourceYAML string       `json:"source-yaml"`
+	OutputYAML string       `json:"output-yaml"`
+	Errors     ConfigErrors `json:"errors"`
+}
+
+// ConfigCompilation is the outcome of compiling a config. Config is set
+// even when the config is invalid, with Compiled left empty.
+type ConfigCompilation struct {
+	Valid  bool
+	Config *PipelineConfig
+	Errors ConfigErrors
+}
+
+type ConfigError struct {
+	Message string `json:"message"`
+	// Path locates the offending node in the config, e.g.
+	// ["jobs", "build", "steps", "0"]. It is empty for errors about the
+	// config as a whole.
+	Path []string `json:"path,omitempty"`
+}
+
+func (e *ConfigError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+	return strings.Join(e.Path, ".") + ": " + e.Message
+}
+
+// ConfigErrors is returned by Validate when the config is rejected.
+type ConfigErrors []*ConfigError
+
+func (e ConfigErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (s *configs) Compile(ctx context.Context, options ConfigCompileOptions) (*ConfigCompilation, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	body := &configCompileRequest{
+		ConfigYAML: *options.Config,
+		Options: configCompileRequestOption{
+			PipelineValues: options.PipelineValues,
+		},
+	}
+	if options.OwnerID != nil {
+		body.Options.OwnerID = *options.OwnerID
+	}
+	if len(options.PipelineParameters) > 0 {
+		params, err := json.Marshal(options.PipelineParameters)
+		if err != nil {
+			return nil, err
+		}
+		body.Options.PipelineParameters = string(params)
+	}
+
+	u := "compile-config-with-defaults"
+	req, err := s.client.newRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &configCompileResponse{}
+	err = s.client.do(ctx, req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConfigCompilation{
+		Valid: resp.Valid,
+		Config: &PipelineConfig{
+			Source:   resp.SourceYAML,
+			Compiled: resp.OutputYAML,
+		},
+		Errors: resp.Errors,
+	}, nil
+}
+
+// Validate returns ConfigErrors when CircleCI would reject the config.
+func (s *configs) Validate(ctx context.Context, options ConfigCompileOptions) error {
+	cc, err := s.Compile(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	if cc.Valid {
+		return nil
+	}
+
+	if len(cc.Errors) == 0 {
+		return ConfigErrors{{Message: "config is invalid"}}
+	}
+
+	return cc.Errors
+}
@@ -0,0 +1,629 @@
+// Package config models CircleCI config YAML as Go types. It understands both
+// compiled configs, as returned in circleci.PipelineConfig, and the source
+// configs they are compiled from. Keys the types don't model are kept in
+// their Extra fields, so that parsing and marshaling a config loses nothing.
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	Version    string                 `yaml:"version"`
+	Setup      bool                   `yaml:"setup,omitempty"`
+	Orbs       map[string]interface{} `yaml:"orbs,omitempty"`
+	Parameters map[string]*Parameter  `yaml:"parameters,omitempty"`
+	Executors  map[string]*Executor   `yaml:"executors,omitempty"`
+	Commands   map[string]*Command    `yaml:"commands,omitempty"`
+	Jobs       map[string]*Job        `yaml:"jobs,omitempty"`
+
+	// Extra holds the top-level keys not modeled above.
+	Extra map[string]interface{} `yaml:",inline"`
+
+	// Workflows is decoded by hand, see UnmarshalYAML.
+	Workflows map[string]*Workflow `yaml:"-"`
+	// WorkflowsVersion is the legacy version key found under workflows. It
+	// is 2 in compiled configs and usually 0 in 2.1 source configs.
+	WorkflowsVersion int `yaml:"-"`
+}
+
+// Parse parses a config.
+func Parse(b []byte) (*Config, error) {
+	c := &Config{}
+	if err := yaml.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Marshal encodes c back into YAML. Key order and some shorthands, such as a
+// single requires written as a string, are normalized, so the output is
+// equivalent to, but not necessarily identical with, the parsed input.
+func Marshal(c *Config) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// plainConfig has the fields of Config without its methods.
+type plainConfig Config
+
+func (c *Config) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode((*plainConfig)(c)); err != nil {
+		return err
+	}
+	// Workflows are decoded below rather than kept as an extra key.
+	delete(c.Extra, "workflows")
+	if len(c.Extra) == 0 {
+		c.Extra = nil
+	}
+
+	workflows := mappingValue(value, "workflows")
+	if workflows == nil {
+		return nil
+	}
+	if workflows.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: workflows must be a map", workflows.Line)
+	}
+
+	c.Workflows = map[string]*Workflow{}
+	for i := 0; i+1 < len(workflows.Content); i += 2 {
+		name, v := workflows.Content[i].Value, workflows.Content[i+1]
+		if name == "version" {
+			if err := v.Decode(&c.WorkflowsVersion); err != nil {
+				return err
+			}
+			continue
+		}
+		w := &Workflow{}
+		if err := v.Decode(w); err != nil {
+			return err
+		}
+		c.Workflows[name] = w
+	}
+
+	return nil
+}
+
+func (c *Config) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{}
+	if err := node.Encode((*plainConfig)(c)); err != nil {
+		return nil, err
+	}
+
+	// Write the version as the number it is conventionally written as,
+	// rather than as the quoted string it is decoded into.
+	if v := mappingValue(node, "version"); v != nil {
+		if _, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			v.Tag, v.Style = "!!float", 0
+			if !strings.Contains(v.Value, ".") {
+				v.Tag = "!!int"
+			}
+		}
+	}
+
+	if c.WorkflowsVersion == 0 && len(c.Workflows) == 0 {
+		return node, nil
+	}
+
+	workflows := &yaml.Node{Kind: yaml.MappingNode}
+	if c.WorkflowsVersion != 0 {
+		if err := appendPair(workflows, "version", c.WorkflowsVersion); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range sortedKeys(c.Workflows) {
+		if err := appendPair(workflows, name, c.Workflows[name]); err != nil {
+			return nil, err
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "workflows"}, workflows)
+
+	return node, nil
+}
+
+type Parameter struct {
+	Type        string      `yaml:"type"`
+	Description string      `yaml:"description,omitempty"`
+	Default     interface{} `yaml:"default,omitempty"`
+	Enum        []string    `yaml:"enum,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// Executor is a named executor definition, which jobs refer to through
+// Job.Executor.
+type Executor struct {
+	Docker           []*DockerImage        `yaml:"docker,omitempty"`
+	Machine          *Machine              `yaml:"machine,omitempty"`
+	MacOS            *MacOS                `yaml:"macos,omitempty"`
+	ResourceClass    string                `yaml:"resource_class,omitempty"`
+	WorkingDirectory string                `yaml:"working_directory,omitempty"`
+	Shell            string                `yaml:"shell,omitempty"`
+	Environment      map[string]string     `yaml:"environment,omitempty"`
+	Parameters       map[string]*Parameter `yaml:"parameters,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type DockerImage struct {
+	Image       string            `yaml:"image"`
+	Name        string            `yaml:"name,omitempty"`
+	Entrypoint  StringList        `yaml:"entrypoint,omitempty"`
+	Command     StringList        `yaml:"command,omitempty"`
+	User        string            `yaml:"user,omitempty"`
+	Environment map[string]string `yaml:"environment,omitempty"`
+	Auth        *DockerAuth       `yaml:"auth,omitempty"`
+	AWSAuth     map[string]string `yaml:"aws_auth,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type DockerAuth struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// Machine is written either as "machine: true" or as a map of options. The
+// former decodes into a zero Machine.
+type Machine struct {
+	Image              string `yaml:"image,omitempty"`
+	DockerLayerCaching Bool   `yaml:"docker_layer_caching,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type plainMachine Machine
+
+func (m *Machine) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		var enabled bool
+		if err := value.Decode(&enabled); err != nil {
+			return err
+		}
+		if !enabled {
+			return fmt.Errorf("line %d: machine must be true or a map", value.Line)
+		}
+		*m = Machine{}
+		return nil
+	}
+	return value.Decode((*plainMachine)(m))
+}
+
+func (m *Machine) MarshalYAML() (interface{}, error) {
+	if m.isShorthand() {
+		return true, nil
+	}
+	return (*plainMachine)(m), nil
+}
+
+// isShorthand reports whether m can be written as "machine: true".
+func (m *Machine) isShorthand() bool {
+	return m.Image == "" && m.DockerLayerCaching == (Bool{}) && len(m.Extra) == 0
+}
+
+type MacOS struct {
+	Xcode string `yaml:"xcode"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type Command struct {
+	Description string                `yaml:"description,omitempty"`
+	Parameters  map[string]*Parameter `yaml:"parameters,omitempty"`
+	Steps       []*Step               `yaml:"steps"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type Job struct {
+	Type             string                `yaml:"type,omitempty"`
+	Executor         *ExecutorRef          `yaml:"executor,omitempty"`
+	Docker           []*DockerImage        `yaml:"docker,omitempty"`
+	Machine          *Machine              `yaml:"machine,omitempty"`
+	MacOS            *MacOS                `yaml:"macos,omitempty"`
+	ResourceClass    string                `yaml:"resource_class,omitempty"`
+	WorkingDirectory string                `yaml:"working_directory,omitempty"`
+	Shell            string                `yaml:"shell,omitempty"`
+	Parallelism      Int                   `yaml:"parallelism,omitempty"`
+	Environment      map[string]string     `yaml:"environment,omitempty"`
+	Parameters       map[string]*Parameter `yaml:"parameters,omitempty"`
+	CircleCIIPRanges Bool                  `yaml:"circleci_ip_ranges,omitempty"`
+	Steps            []*Step               `yaml:"steps,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// ExecutorRef refers to a named executor, either as "executor: name" or with
+// parameters as "executor: {name: name, ...}".
+type ExecutorRef struct {
+	Name   string
+	Params map[string]interface{}
+}
+
+func (e *ExecutorRef) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*e = ExecutorRef{Name: value.Value}
+		return nil
+	}
+
+	params := map[string]interface{}{}
+	if err := value.Decode(&params); err != nil {
+		return err
+	}
+	name, ok := params["name"].(string)
+	if !ok {
+		return fmt.Errorf("line %d: executor name is required", value.Line)
+	}
+	delete(params, "name")
+	if len(params) == 0 {
+		params = nil
+	}
+
+	*e = ExecutorRef{Name: name, Params: params}
+	return nil
+}
+
+func (e *ExecutorRef) MarshalYAML() (interface{}, error) {
+	if len(e.Params) == 0 {
+		return e.Name, nil
+	}
+
+	m := map[string]interface{}{"name": e.Name}
+	for k, v := range e.Params {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// Step is a single step of a job or command. Built-in steps without options,
+// like "checkout", only have a Type. Run steps have Run set, and any other
+// step, including orb and command invocations, keeps its options in Params.
+type Step struct {
+	// Type is the key of the step, e.g. "run", "checkout" or a command name.
+	Type   string
+	Run    *RunStep
+	Params map[string]interface{}
+}
+
+type RunStep struct {
+	Name             string            `yaml:"name,omitempty"`
+	Command          string            `yaml:"command"`
+	Shell            string            `yaml:"shell,omitempty"`
+	Environment      map[string]string `yaml:"environment,omitempty"`
+	Background       Bool              `yaml:"background,omitempty"`
+	WorkingDirectory string            `yaml:"working_directory,omitempty"`
+	NoOutputTimeout  string            `yaml:"no_output_timeout,omitempty"`
+	When             string            `yaml:"when,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// isShorthand reports whether r can be written as "run: <command>".
+func (r *RunStep) isShorthand() bool {
+	return r.Name == "" && r.Shell == "" && len(r.Environment) == 0 && r.Background == (Bool{}) &&
+		r.WorkingDirectory == "" && r.NoOutputTimeout == "" && r.When == "" && len(r.Extra) == 0
+}
+
+func (s *Step) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		*s = Step{Type: value.Value}
+		return nil
+	case yaml.MappingNode:
+		if len(value.Content) != 2 {
+			return fmt.Errorf("line %d: a step must have exactly one key", value.Line)
+		}
+	default:
+		return fmt.Errorf("line %d: a step must be a string or a map", value.Line)
+	}
+
+	typ, v := value.Content[0].Value, value.Content[1]
+	*s = Step{Type: typ}
+
+	if typ == "run" {
+		s.Run = &RunStep{}
+		if v.Kind == yaml.ScalarNode {
+			s.Run.Command = v.Value
+			return nil
+		}
+		return v.Decode(s.Run)
+	}
+
+	if v.Tag == "!!null" {
+		return nil
+	}
+	return v.Decode(&s.Params)
+}
+
+func (s *Step) MarshalYAML() (interface{}, error) {
+	if s.Run != nil {
+		// Use the shorthand form when the command is all there is.
+		if s.Run.isShorthand() {
+			return map[string]string{"run": s.Run.Command}, nil
+		}
+		return map[string]*RunStep{"run": s.Run}, nil
+	}
+
+	if s.Params == nil {
+		return s.Type, nil
+	}
+	return map[string]interface{}{s.Type: s.Params}, nil
+}
+
+type Workflow struct {
+	Triggers []*Trigger     `yaml:"triggers,omitempty"`
+	When     interface{}    `yaml:"when,omitempty"`
+	Unless   interface{}    `yaml:"unless,omitempty"`
+	Jobs     []*WorkflowJob `yaml:"jobs"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type Trigger struct {
+	Schedule *Schedule `yaml:"schedule"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type Schedule struct {
+	Cron    string   `yaml:"cron"`
+	Filters *Filters `yaml:"filters,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// WorkflowJob is an entry of a workflow's job list. Job is the name of the
+// job being run and Name the optional name it runs under in the workflow.
+// Parameters passed to the job are kept in Params.
+type WorkflowJob struct {
+	Job       string
+	Name      string
+	Type      string
+	Requires  StringList
+	Context   StringList
+	Filters   *Filters
+	Matrix    *Matrix
+	PreSteps  []*Step
+	PostSteps []*Step
+	Params    map[string]interface{}
+}
+
+type workflowJobOptions struct {
+	Name      string     `yaml:"name,omitempty"`
+	Type      string     `yaml:"type,omitempty"`
+	Requires  StringList `yaml:"requires,omitempty"`
+	Context   StringList `yaml:"context,omitempty"`
+	Filters   *Filters   `yaml:"filters,omitempty"`
+	Matrix    *Matrix    `yaml:"matrix,omitempty"`
+	PreSteps  []*Step    `yaml:"pre-steps,omitempty"`
+	PostSteps []*Step    `yaml:"post-steps,omitempty"`
+}
+
+var workflowJobKeys = []string{"name", "type", "requires", "context", "filters", "matrix", "pre-steps", "post-steps"}
+
+// ID returns the name the job is referred to by within its workflow, e.g. in
+// the requires of other jobs.
+func (j *WorkflowJob) ID() string {
+	if j.Name != "" {
+		return j.Name
+	}
+	return j.Job
+}
+
+func (j *WorkflowJob) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		*j = WorkflowJob{Job: value.Value}
+		return nil
+	case yaml.MappingNode:
+		if len(value.Content) != 2 {
+			return fmt.Errorf("line %d: a workflow job must have exactly one key", value.Line)
+		}
+	default:
+		return fmt.Errorf("line %d: a workflow job must be a string or a map", value.Line)
+	}
+
+	*j = WorkflowJob{Job: value.Content[0].Value}
+	v := value.Content[1]
+	if v.Tag == "!!null" {
+		return nil
+	}
+
+	var o workflowJobOptions
+	if err := v.Decode(&o); err != nil {
+		return err
+	}
+	j.Name, j.Type, j.Requires, j.Context = o.Name, o.Type, o.Requires, o.Context
+	j.Filters, j.Matrix, j.PreSteps, j.PostSteps = o.Filters, o.Matrix, o.PreSteps, o.PostSteps
+
+	var params map[string]interface{}
+	if err := v.Decode(&params); err != nil {
+		return err
+	}
+	for _, k := range workflowJobKeys {
+		delete(params, k)
+	}
+	if len(params) > 0 {
+		j.Params = params
+	}
+
+	return nil
+}
+
+func (j *WorkflowJob) MarshalYAML() (interface{}, error) {
+	o := &workflowJobOptions{
+		Name:      j.Name,
+		Type:      j.Type,
+		Requires:  j.Requires,
+		Context:   j.Context,
+		Filters:   j.Filters,
+		Matrix:    j.Matrix,
+		PreSteps:  j.PreSteps,
+		PostSteps: j.PostSteps,
+	}
+
+	options := &yaml.Node{}
+	if err := options.Encode(o); err != nil {
+		return nil, err
+	}
+	for _, k := range sortedKeys(j.Params) {
+		if err := appendPair(options, k, j.Params[k]); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(options.Content) == 0 {
+		return j.Job, nil
+	}
+
+	return &yaml.Node{
+		Kind:    yaml.MappingNode,
+		Content: []*yaml.Node{{Kind: yaml.ScalarNode, Value: j.Job}, options},
+	}, nil
+}
+
+type Filters struct {
+	Branches *FilterRule `yaml:"branches,omitempty"`
+	Tags     *FilterRule `yaml:"tags,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// FilterRule lists branch or tag names, or regular expressions delimited by
+// slashes such as /^release-.*/.
+type FilterRule struct {
+	Only   StringList `yaml:"only,omitempty"`
+	Ignore StringList `yaml:"ignore,omitempty"`
+}
+
+type Matrix struct {
+	Alias      string                   `yaml:"alias,omitempty"`
+	Parameters map[string][]interface{} `yaml:"parameters"`
+	Exclude    []map[string]interface{} `yaml:"exclude,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// Int is an integer field that source configs may also set to a parameter
+// interpolation such as "<< parameters.parallelism >>", which is kept as is
+// in Expr.
+type Int struct {
+	Value int
+	Expr  string
+}
+
+func (i *Int) UnmarshalYAML(value *yaml.Node) error {
+	if isInterpolation(value) {
+		*i = Int{Expr: value.Value}
+		return nil
+	}
+	var n int
+	if err := value.Decode(&n); err != nil {
+		return err
+	}
+	*i = Int{Value: n}
+	return nil
+}
+
+func (i Int) MarshalYAML() (interface{}, error) {
+	if i.Expr != "" {
+		return i.Expr, nil
+	}
+	return i.Value, nil
+}
+
+// Bool is the boolean counterpart of Int.
+type Bool struct {
+	Value bool
+	Expr  string
+}
+
+func (b *Bool) UnmarshalYAML(value *yaml.Node) error {
+	if isInterpolation(value) {
+		*b = Bool{Expr: value.Value}
+		return nil
+	}
+	var v bool
+	if err := value.Decode(&v); err != nil {
+		return err
+	}
+	*b = Bool{Value: v}
+	return nil
+}
+
+func (b Bool) MarshalYAML() (interface{}, error) {
+	if b.Expr != "" {
+		return b.Expr, nil
+	}
+	return b.Value, nil
+}
+
+// isInterpolation reports whether value is a string made of, or containing,
+// a << ... >> parameter interpolation.
+func isInterpolation(value *yaml.Node) bool {
+	return value.Kind == yaml.ScalarNode && strings.Contains(value.Value, "<<")
+}
+
+// StringList is a list of strings that may also be written as a single
+// string, as is the case for requires, context and filters.
+type StringList []string
+
+func (l *StringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = StringList{value.Value}
+		return nil
+	}
+	return value.Decode((*[]string)(l))
+}
+
+// mappingValue returns the value of key in the mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// appendPair encodes v and adds it under key to the mapping node.
+func appendPair(node *yaml.Node, key string, v interface{}) error {
+	value := &yaml.Node{}
+	if err := value.Encode(v); err != nil {
+		return err
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+	return nil
+}
+
+// sortedKeys returns the keys of m, which must be a map keyed by strings.
+func sortedKeys(m interface{}) []string {
+	v := reflect.ValueOf(m)
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
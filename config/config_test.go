@@ -0,0 +1,343 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v3"
+)
+
+const compiledConfig = `version: 2
+jobs:
+  build:
+    docker:
+      - image: cimg/go:1.21
+        auth:
+          username: user1
+          password: $DOCKER_PASSWORD
+      - image: cimg/postgres:14.0
+        environment:
+          POSTGRES_USER: root
+          POSTGRES_PORT: 5432
+    resource_class: large
+    parallelism: 2
+    steps:
+      - checkout
+      - run: go build ./...
+      - run:
+          name: Test
+          command: go test ./...
+          no_output_timeout: 20m
+      - store_test_results:
+          path: results
+  deploy:
+    machine:
+      image: ubuntu-2204:current
+    steps:
+      - run: ./deploy.sh
+  hold:
+    type: approval
+workflows:
+  version: 2
+  main:
+    jobs:
+      - build
+      - hold:
+          requires: build
+          filters:
+            branches:
+              only: main
+      - deploy:
+          name: deploy-production
+          requires:
+            - hold
+          context: [aws, slack]
+          environment: production
+`
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(compiledConfig))
+	if err != nil {
+		t.Fatalf("Parse got error: %v", err)
+	}
+
+	want := &Config{
+		Version: "2",
+		Jobs: map[string]*Job{
+			"build": {
+				Docker: []*DockerImage{
+					{
+						Image: "cimg/go:1.21",
+						Auth:  &DockerAuth{Username: "user1", Password: "$DOCKER_PASSWORD"},
+					},
+					{
+						Image:       "cimg/postgres:14.0",
+						Environment: map[string]string{"POSTGRES_USER": "root", "POSTGRES_PORT": "5432"},
+					},
+				},
+				ResourceClass: "large",
+				Parallelism:   Int{Value: 2},
+				Steps: []*Step{
+					{Type: "checkout"},
+					{Type: "run", Run: &RunStep{Command: "go build ./..."}},
+					{Type: "run", Run: &RunStep{Name: "Test", Command: "go test ./...", NoOutputTimeout: "20m"}},
+					{Type: "store_test_results", Params: map[string]interface{}{"path": "results"}},
+				},
+			},
+			"deploy": {
+				Machine: &Machine{Image: "ubuntu-2204:current"},
+				Steps: []*Step{
+					{Type: "run", Run: &RunStep{Command: "./deploy.sh"}},
+				},
+			},
+			"hold": {
+				Type: "approval",
+			},
+		},
+		Workflows: map[string]*Workflow{
+			"main": {
+				Jobs: []*WorkflowJob{
+					{Job: "build"},
+					{
+						Job:      "hold",
+						Requires: StringList{"build"},
+						Filters:  &Filters{Branches: &FilterRule{Only: StringList{"main"}}},
+					},
+					{
+						Job:      "deploy",
+						Name:     "deploy-production",
+						Requires: StringList{"hold"},
+						Context:  StringList{"aws", "slack"},
+						Params:   map[string]interface{}{"environment": "production"},
+					},
+				},
+			},
+		},
+		WorkflowsVersion: 2,
+	}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("Parse got diff (-got +want):\n%s", cmp.Diff(c, want))
+	}
+}
+
+const sourceConfig = `version: 2.1
+setup: true
+orbs:
+  node: circleci/node@5.0.0
+parameters:
+  run-deploy:
+    type: boolean
+    default: false
+executors:
+  go:
+    parameters:
+      tag:
+        type: string
+        default: "1.21"
+    docker:
+      - image: cimg/go:<< parameters.tag >>
+commands:
+  install:
+    steps:
+      - run: go mod download
+jobs:
+  test:
+    executor:
+      name: go
+      tag: "1.20"
+    steps:
+      - checkout
+      - install
+      - node/install-packages:
+          pkg-manager: yarn
+  mac:
+    macos:
+      xcode: 15.0.0
+    machine: true
+    steps:
+      - run:
+          command: make
+          environment:
+            CGO_ENABLED: "1"
+workflows:
+  test:
+    when: << pipeline.parameters.run-deploy >>
+    jobs:
+      - test:
+          matrix:
+            parameters:
+              tag: ["1.20", "1.21"]
+      - mac:
+          pre-steps:
+            - checkout
+  nightly:
+    triggers:
+      - schedule:
+          cron: "0 0 * * *"
+          filters:
+            branches:
+              only:
+                - main
+    jobs:
+      - test
+`
+
+func TestMarshal_roundTrip(t *testing.T) {
+	for name, in := range map[string]string{
+		"compiled":      compiledConfig,
+		"source":        sourceConfig,
+		"parameterized": parameterizedConfig,
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := Parse([]byte(in))
+			if err != nil {
+				t.Fatalf("Parse got error: %v", err)
+			}
+
+			out, err := Marshal(c)
+			if err != nil {
+				t.Fatalf("Marshal got error: %v", err)
+			}
+
+			got, err := Parse(out)
+			if err != nil {
+				t.Fatalf("Parse of marshaled config got error: %v\n%s", err, out)
+			}
+
+			if !cmp.Equal(got, c) {
+				t.Errorf("round trip got diff (-got +want):\n%s", cmp.Diff(got, c))
+			}
+
+		})
+	}
+}
+
+// parameterizedConfig uses parameter interpolations in typed fields and has
+// keys the types don't model.
+const parameterizedConfig = `version: 2.1
+x-anchors:
+  go-image: cimg/go:1.21
+jobs:
+  test:
+    description: Runs the tests
+    parameters:
+      parallelism:
+        type: integer
+        default: 1
+      background:
+        type: boolean
+        default: false
+    docker:
+      - image: cimg/go:1.21
+    parallelism: << parameters.parallelism >>
+    circleci_ip_ranges: << pipeline.parameters.ip-ranges >>
+    steps:
+      - run:
+          command: ./server
+          background: << parameters.background >>
+          max_auto_reruns: 2
+  build:
+    machine:
+      image: ubuntu-2204:current
+      docker_layer_caching: << parameters.dlc >>
+    steps:
+      - checkout
+workflows:
+  main:
+    max_auto_reruns: 3
+    jobs:
+      - test:
+          parallelism: 4
+`
+
+func TestParse_parameterized(t *testing.T) {
+	c, err := Parse([]byte(parameterizedConfig))
+	if err != nil {
+		t.Fatalf("Parse got error: %v", err)
+	}
+
+	job := c.Jobs["test"]
+	if got, want := job.Parallelism, (Int{Expr: "<< parameters.parallelism >>"}); got != want {
+		t.Errorf("Job.Parallelism got %+v, want %+v", got, want)
+	}
+	if got, want := job.CircleCIIPRanges, (Bool{Expr: "<< pipeline.parameters.ip-ranges >>"}); got != want {
+		t.Errorf("Job.CircleCIIPRanges got %+v, want %+v", got, want)
+	}
+	if got, want := job.Steps[0].Run.Background, (Bool{Expr: "<< parameters.background >>"}); got != want {
+		t.Errorf("RunStep.Background got %+v, want %+v", got, want)
+	}
+	if got, want := c.Jobs["build"].Machine.DockerLayerCaching, (Bool{Expr: "<< parameters.dlc >>"}); got != want {
+		t.Errorf("Machine.DockerLayerCaching got %+v, want %+v", got, want)
+	}
+
+	if got, want := job.Extra, map[string]interface{}{"description": "Runs the tests"}; !cmp.Equal(got, want) {
+		t.Errorf("Job.Extra got %v, want %v", got, want)
+	}
+	if got, want := c.Workflows["main"].Extra, map[string]interface{}{"max_auto_reruns": 3}; !cmp.Equal(got, want) {
+		t.Errorf("Workflow.Extra got %v, want %v", got, want)
+	}
+	if got, want := c.Extra, map[string]interface{}{"x-anchors": map[string]interface{}{"go-image": "cimg/go:1.21"}}; !cmp.Equal(got, want) {
+		t.Errorf("Config.Extra got %v, want %v", got, want)
+	}
+
+	if _, err := Parse([]byte("version: 2.1\njobs:\n  test:\n    parallelism: many\n")); err == nil {
+		t.Errorf("Parse got no error for a non-integer parallelism")
+	}
+}
+
+func TestMarshal_keepsUnknownKeys(t *testing.T) {
+	c, err := Parse([]byte(parameterizedConfig))
+	if err != nil {
+		t.Fatalf("Parse got error: %v", err)
+	}
+
+	out, err := Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal got error: %v", err)
+	}
+
+	// The config uses no shorthand Marshal normalizes, so nothing of it may
+	// change along the way.
+	var got, want interface{}
+	if err := yaml.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := yaml.Unmarshal([]byte(parameterizedConfig), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Marshal got diff (-got +want):\n%s", cmp.Diff(got, want))
+	}
+}
+
+func TestParse_source(t *testing.T) {
+	c, err := Parse([]byte(sourceConfig))
+	if err != nil {
+		t.Fatalf("Parse got error: %v", err)
+	}
+
+	if got, want := c.Jobs["test"].Executor, (&ExecutorRef{Name: "go", Params: map[string]interface{}{"tag": "1.20"}}); !cmp.Equal(got, want) {
+		t.Errorf("Job.Executor got %+v, want %+v", got, want)
+	}
+
+	if got := c.Jobs["mac"].Machine; got == nil || !got.isShorthand() {
+		t.Errorf("Job.Machine got %+v, want an empty Machine", got)
+	}
+
+	if got, want := c.Jobs["test"].Steps[2], (&Step{Type: "node/install-packages", Params: map[string]interface{}{"pkg-manager": "yarn"}}); !cmp.Equal(got, want) {
+		t.Errorf("Step got %+v, want %+v", got, want)
+	}
+
+	if got, want := c.Workflows["test"].Jobs[0].Matrix, (&Matrix{Parameters: map[string][]interface{}{"tag": {"1.20", "1.21"}}}); !cmp.Equal(got, want) {
+		t.Errorf("WorkflowJob.Matrix got %+v, want %+v", got, want)
+	}
+
+	if got, want := c.Workflows["nightly"].Triggers[0].Schedule.Cron, "0 0 * * *"; got != want {
+		t.Errorf("Schedule.Cron got %q, want %q", got, want)
+	}
+
+	if c.WorkflowsVersion != 0 {
+		t.Errorf("Config.WorkflowsVersion got %d, want 0", c.WorkflowsVersion)
+	}
+}
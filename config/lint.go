@@ -0,0 +1,476 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Position locates a problem in a config file. Column is 0 when only the
+// line is known.
+type Position struct {
+	Filename string
+	Line     int
+	Column   int
+}
+
+func (p Position) String() string {
+	s := p.Filename
+	if s == "" {
+		s = "<config>"
+	}
+	if p.Line > 0 {
+		s += ":" + strconv.Itoa(p.Line)
+		if p.Column > 0 {
+			s += ":" + strconv.Itoa(p.Column)
+		}
+	}
+	return s
+}
+
+// Problem is a single finding of Lint.
+type Problem struct {
+	Pos     Position
+	Message string
+}
+
+func (p *Problem) String() string {
+	return p.Pos.String() + ": " + p.Message
+}
+
+// Lint checks the config in src for structural errors without contacting
+// CircleCI. filename is only used to report positions. Problems are returned
+// in the order they appear in the file.
+func Lint(filename string, src []byte) []*Problem {
+	l := &linter{filename: filename}
+	l.lint(src)
+
+	sort.SliceStable(l.problems, func(i, j int) bool {
+		a, b := l.problems[i].Pos, l.problems[j].Pos
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	return l.problems
+}
+
+type linter struct {
+	filename string
+	root     *yaml.Node
+	config   *Config
+	problems []*Problem
+}
+
+func (l *linter) lint(src []byte) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(src, doc); err != nil {
+		l.yamlError(err)
+		return
+	}
+	if len(doc.Content) == 0 {
+		l.problems = append(l.problems, &Problem{Pos: Position{Filename: l.filename}, Message: "config is empty"})
+		return
+	}
+	l.root = doc.Content[0]
+	if l.root.Kind != yaml.MappingNode {
+		l.addf(l.root, "config must be a map")
+		return
+	}
+
+	l.checkSchema()
+
+	c := &Config{}
+	if err := doc.Decode(c); err != nil {
+		l.yamlError(err)
+		return
+	}
+	l.config = c
+
+	l.checkParameters([]interface{}{}, c.Parameters)
+	for _, name := range sortedKeys(c.Executors) {
+		l.checkParameters([]interface{}{"executors", name}, c.Executors[name].Parameters)
+	}
+	for _, name := range sortedKeys(c.Commands) {
+		l.checkParameters([]interface{}{"commands", name}, c.Commands[name].Parameters)
+	}
+	for _, name := range sortedKeys(c.Jobs) {
+		l.checkParameters([]interface{}{"jobs", name}, c.Jobs[name].Parameters)
+		l.checkExecutor(name, c.Jobs[name])
+	}
+	for _, name := range sortedKeys(c.Workflows) {
+		l.checkWorkflow(name, c.Workflows[name])
+	}
+}
+
+var yamlErrorLine = regexp.MustCompile(`line (\d+): (.*)`)
+
+// yamlError turns the errors of the YAML decoder, which embed line numbers
+// in their messages, into problems.
+func (l *linter) yamlError(err error) {
+	found := false
+	for _, m := range yamlErrorLine.FindAllStringSubmatch(err.Error(), -1) {
+		line, _ := strconv.Atoi(m[1])
+		l.problems = append(l.problems, &Problem{
+			Pos:     Position{Filename: l.filename, Line: line},
+			Message: m[2],
+		})
+		found = true
+	}
+	if !found {
+		l.problems = append(l.problems, &Problem{
+			Pos:     Position{Filename: l.filename},
+			Message: strings.TrimPrefix(err.Error(), "yaml: "),
+		})
+	}
+}
+
+func (l *linter) addf(node *yaml.Node, format string, args ...interface{}) {
+	l.problems = append(l.problems, &Problem{
+		Pos:     Position{Filename: l.filename, Line: node.Line, Column: node.Column},
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// node returns the node at path, made of map keys and sequence indexes. When
+// path can't be followed, it returns the deepest node it reached so that
+// problems are still reported close to their cause.
+func (l *linter) node(path ...interface{}) *yaml.Node {
+	n := l.root
+	for _, p := range path {
+		var next *yaml.Node
+		switch p := p.(type) {
+		case string:
+			next = mappingValue(n, p)
+		case int:
+			if n.Kind == yaml.SequenceNode && p < len(n.Content) {
+				next = n.Content[p]
+			}
+		}
+		if next == nil {
+			return n
+		}
+		n = next
+	}
+	return n
+}
+
+var (
+	topLevelKeys    = []string{"version", "setup", "orbs", "parameters", "executors", "commands", "jobs", "workflows"}
+	parameterKeys   = []string{"type", "description", "default", "enum"}
+	executorKeys    = []string{"docker", "machine", "macos", "resource_class", "working_directory", "shell", "environment", "parameters", "description"}
+	jobKeys         = []string{"type", "executor", "docker", "machine", "macos", "resource_class", "working_directory", "shell", "parallelism", "environment", "parameters", "circleci_ip_ranges", "steps", "description"}
+	dockerImageKeys = []string{"image", "name", "entrypoint", "command", "user", "environment", "auth", "aws_auth"}
+	machineKeys     = []string{"image", "docker_layer_caching"}
+	commandKeys     = []string{"description", "parameters", "steps"}
+	runStepKeys     = []string{"name", "command", "shell", "environment", "background", "working_directory", "no_output_timeout", "when"}
+	workflowKeys    = []string{"triggers", "when", "unless", "jobs", "max_auto_reruns"}
+	scheduleKeys    = []string{"cron", "filters"}
+	filtersKeys     = []string{"branches", "tags"}
+	filterRuleKeys  = []string{"only", "ignore"}
+	matrixKeys      = []string{"alias", "parameters", "exclude"}
+)
+
+// checkSchema reports unknown keys. It works on the raw nodes so that it
+// also runs when the config doesn't decode.
+func (l *linter) checkSchema() {
+	l.checkKeys(l.root, "config", topLevelKeys)
+
+	eachValue(mappingValue(l.root, "parameters"), func(_ string, p *yaml.Node) {
+		l.checkKeys(p, "parameter", parameterKeys)
+	})
+	eachValue(mappingValue(l.root, "executors"), func(_ string, e *yaml.Node) {
+		l.checkKeys(e, "executor", executorKeys)
+		l.checkExecutorSchema(e)
+	})
+	eachValue(mappingValue(l.root, "commands"), func(_ string, c *yaml.Node) {
+		l.checkKeys(c, "command", commandKeys)
+		l.checkStepsSchema(mappingValue(c, "steps"))
+	})
+	eachValue(mappingValue(l.root, "jobs"), func(_ string, j *yaml.Node) {
+		l.checkKeys(j, "job", jobKeys)
+		l.checkExecutorSchema(j)
+		l.checkStepsSchema(mappingValue(j, "steps"))
+	})
+	eachValue(mappingValue(l.root, "workflows"), func(name string, w *yaml.Node) {
+		if name == "version" {
+			return
+		}
+		l.checkKeys(w, "workflow", workflowKeys)
+		eachItem(mappingValue(w, "triggers"), func(t *yaml.Node) {
+			l.checkKeys(t, "trigger", []string{"schedule"})
+			s := mappingValue(t, "schedule")
+			l.checkKeys(s, "schedule", scheduleKeys)
+			l.checkFiltersSchema(mappingValue(s, "filters"))
+		})
+		eachItem(mappingValue(w, "jobs"), func(j *yaml.Node) {
+			// The other options of a workflow job are parameters of the job.
+			eachValue(j, func(_ string, o *yaml.Node) {
+				l.checkFiltersSchema(mappingValue(o, "filters"))
+				l.checkKeys(mappingValue(o, "matrix"), "matrix", matrixKeys)
+			})
+		})
+	})
+}
+
+func (l *linter) checkExecutorSchema(n *yaml.Node) {
+	eachValue(mappingValue(n, "parameters"), func(_ string, p *yaml.Node) {
+		l.checkKeys(p, "parameter", parameterKeys)
+	})
+	eachItem(mappingValue(n, "docker"), func(d *yaml.Node) {
+		l.checkKeys(d, "docker image", dockerImageKeys)
+	})
+	l.checkKeys(mappingValue(n, "machine"), "machine", machineKeys)
+}
+
+func (l *linter) checkStepsSchema(n *yaml.Node) {
+	eachItem(n, func(s *yaml.Node) {
+		if run := mappingValue(s, "run"); run != nil {
+			l.checkKeys(run, "run step", runStepKeys)
+		}
+	})
+}
+
+func (l *linter) checkFiltersSchema(n *yaml.Node) {
+	l.checkKeys(n, "filters", filtersKeys)
+	eachValue(n, func(_ string, r *yaml.Node) {
+		l.checkKeys(r, "filter", filterRuleKeys)
+	})
+}
+
+// checkKeys reports the keys of the mapping n that are not in allowed. Other
+// nodes are left alone, the decoder reports them if they are wrong.
+func (l *linter) checkKeys(n *yaml.Node, what string, allowed []string) {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k := n.Content[i]
+		if !contains(allowed, k.Value) {
+			l.addf(k, "unknown key %q in %s", k.Value, what)
+		}
+	}
+}
+
+var parameterTypes = []string{"string", "boolean", "integer", "enum", "executor", "steps", "env_var_name"}
+
+func (l *linter) checkParameters(scope []interface{}, params map[string]*Parameter) {
+	for _, name := range sortedKeys(params) {
+		p := params[name]
+		path := append(append([]interface{}{}, scope...), "parameters", name)
+
+		if !contains(parameterTypes, p.Type) {
+			l.addf(l.node(append(path, "type")...), "parameter %q has invalid type %q", name, p.Type)
+			continue
+		}
+
+		if p.Type == "enum" && len(p.Enum) == 0 {
+			l.addf(l.node(path...), "enum parameter %q has no enum values", name)
+		}
+
+		if p.Default == nil {
+			continue
+		}
+		valid := true
+		switch p.Type {
+		case "boolean":
+			_, valid = p.Default.(bool)
+		case "integer":
+			_, valid = p.Default.(int)
+		case "enum":
+			s, ok := p.Default.(string)
+			valid = ok && contains(p.Enum, s)
+		}
+		if !valid {
+			l.addf(l.node(append(path, "default")...), "default of %s parameter %q is invalid", p.Type, name)
+		}
+	}
+}
+
+func (l *linter) checkExecutor(name string, j *Job) {
+	if j.Type != "" && j.Type != "build" {
+		return
+	}
+
+	if j.Executor != nil {
+		if _, ok := l.config.Executors[j.Executor.Name]; !ok && !l.fromOrb(j.Executor.Name) {
+			l.addf(l.node("jobs", name, "executor"), "executor %q is not defined", j.Executor.Name)
+		}
+		return
+	}
+
+	if len(j.Docker) == 0 && j.Machine == nil && j.MacOS == nil {
+		l.addf(l.node("jobs", name), "job %q has no executor", name)
+	}
+}
+
+// fromOrb reports whether name, such as node/default, refers to an orb the
+// config imports.
+func (l *linter) fromOrb(name string) bool {
+	i := strings.Index(name, "/")
+	if i < 0 {
+		return false
+	}
+	_, ok := l.config.Orbs[name[:i]]
+	return ok
+}
+
+// contextName matches the context names accepted by CircleCI. Names built
+// from pipeline parameters are not checked.
+var contextName = regexp.MustCompile(`^[A-Za-z0-9_.-]+( [A-Za-z0-9_.-]+)*$`)
+
+func (l *linter) checkWorkflow(name string, w *Workflow) {
+	ids := map[string]int{}
+	var matrixPrefixes []string
+	for i, j := range w.Jobs {
+		path := []interface{}{"workflows", name, "jobs", i}
+
+		// Approval jobs are declared in the workflow only, e.g. as
+		// "- hold: {type: approval}".
+		if _, ok := l.config.Jobs[j.Job]; !ok && j.Type != "approval" && !l.fromOrb(j.Job) {
+			l.addf(l.node(path...), "job %q is not defined", j.Job)
+		}
+
+		if prev, ok := ids[j.ID()]; ok && j.Matrix == nil {
+			l.addf(l.node(path...), "job %q is already in workflow %q at index %d", j.ID(), name, prev)
+		}
+		ids[j.ID()] = i
+		if j.Matrix != nil {
+			// A matrix job can be required as a whole by its alias, which
+			// defaults to the job name.
+			alias := j.Matrix.Alias
+			if alias == "" {
+				alias = j.Job
+			}
+			if _, ok := ids[alias]; !ok {
+				ids[alias] = i
+			}
+			matrixPrefixes = append(matrixPrefixes, matrixPrefix(j.ID()))
+		}
+
+		for _, c := range j.Context {
+			if strings.Contains(c, "<<") {
+				continue
+			}
+			if !contextName.MatchString(c) {
+				l.addf(l.node(append(path, j.Job, "context")...), "invalid context name %q", c)
+			}
+		}
+	}
+
+	requires := map[string][]string{}
+	for i, j := range w.Jobs {
+		for _, r := range j.Requires {
+			if _, ok := ids[r]; ok {
+				requires[j.ID()] = append(requires[j.ID()], r)
+				continue
+			}
+			if hasMatrixPrefix(r, matrixPrefixes) {
+				continue
+			}
+			l.addf(l.node("workflows", name, "jobs", i, j.Job, "requires"), "job %q requires %q, which is not in workflow %q", j.ID(), r, name)
+		}
+	}
+
+	for _, cycle := range findCycles(w.Jobs, requires) {
+		l.addf(l.node("workflows", name, "jobs", ids[cycle[0]]), "cyclic requires in workflow %q: %s", name, strings.Join(cycle, " -> "))
+	}
+}
+
+// matrixPrefix returns the prefix shared by the names of the jobs expanded
+// from a matrix job. Names are the job's name followed by the parameter
+// values, e.g. test-1.20 for the matrix job test, unless the name
+// interpolates the values itself, e.g. test-<< matrix.go >>.
+func matrixPrefix(id string) string {
+	if i := strings.Index(id, "<<"); i >= 0 {
+		return id[:i]
+	}
+	return id + "-"
+}
+
+// hasMatrixPrefix reports whether id names a job expanded from a matrix.
+func hasMatrixPrefix(id string, matrixPrefixes []string) bool {
+	for _, p := range matrixPrefixes {
+		if strings.HasPrefix(id, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// findCycles returns each cycle of the requires graph once, as the path
+// from its first job back to that job.
+func findCycles(jobs []*WorkflowJob, requires map[string][]string) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := map[string]int{}
+	var (
+		stack  []string
+		cycles [][]string
+		visit  func(id string)
+	)
+	visit = func(id string) {
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, r := range requires[id] {
+			switch state[r] {
+			case unvisited:
+				visit(r)
+			case visiting:
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == r {
+						cycle := append(append([]string{}, stack[i:]...), r)
+						cycles = append(cycles, cycle)
+						break
+					}
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = done
+	}
+
+	for _, j := range jobs {
+		if state[j.ID()] == unvisited {
+			visit(j.ID())
+		}
+	}
+	return cycles
+}
+
+// eachValue calls fn for each entry of the mapping n.
+func eachValue(n *yaml.Node, fn func(key string, value *yaml.Node)) {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		fn(n.Content[i].Value, n.Content[i+1])
+	}
+}
+
+// eachItem calls fn for each item of the sequence n.
+func eachItem(n *yaml.Node, fn func(item *yaml.Node)) {
+	if n == nil || n.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, item := range n.Content {
+		fn(item)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLint(t *testing.T) {
+	src := `version: 2.1
+orbs:
+  node: circleci/node@5.0.0
+parameters:
+  deploy:
+    type: bool
+  region:
+    type: enum
+    enum: [us, eu]
+    default: ap
+executors:
+  go:
+    docker:
+      - image: cimg/go:1.21
+        imagee: typo
+jobs:
+  build:
+    executor: go
+    steps:
+      - checkout
+  test:
+    executor: golang
+    steps:
+      - run:
+          command: go test ./...
+          timeout: 10m
+  lint:
+    steps:
+      - checkout
+  publish:
+    executor: node/default
+    steps:
+      - checkout
+workflows:
+  main:
+    jobs:
+      - build:
+          requires: [test]
+      - test:
+          requires: [publish]
+          context: [" bad context"]
+      - publish:
+          requires: [build, lint]
+      - lint
+      - node/test
+      - release
+      - deploy:
+          requires: [missing]
+`
+
+	got := Lint("config.yml", []byte(src))
+
+	want := []string{
+		"config.yml:6:11: parameter \"deploy\" has invalid type \"bool\"",
+		"config.yml:10:14: default of enum parameter \"region\" is invalid",
+		"config.yml:15:9: unknown key \"imagee\" in docker image",
+		"config.yml:22:15: executor \"golang\" is not defined",
+		"config.yml:26:11: unknown key \"timeout\" in run step",
+		"config.yml:28:5: job \"lint\" has no executor",
+		"config.yml:37:9: cyclic requires in workflow \"main\": build -> test -> publish -> build",
+		"config.yml:41:20: invalid context name \" bad context\"",
+		"config.yml:46:9: job \"release\" is not defined",
+		"config.yml:47:9: job \"deploy\" is not defined",
+		"config.yml:48:21: job \"deploy\" requires \"missing\", which is not in workflow \"main\"",
+	}
+
+	var gotStrings []string
+	for _, p := range got {
+		gotStrings = append(gotStrings, p.String())
+	}
+
+	if !cmp.Equal(gotStrings, want) {
+		t.Errorf("Lint got diff (-got +want):\n%s", cmp.Diff(gotStrings, want))
+	}
+}
+
+func TestLint_noExecutor(t *testing.T) {
+	src := `version: 2.1
+jobs:
+  build:
+    steps:
+      - checkout
+  hold:
+    type: approval
+workflows:
+  main:
+    jobs:
+      - build
+`
+
+	want := []*Problem{
+		{Pos: Position{Filename: "config.yml", Line: 4, Column: 5}, Message: "job \"build\" has no executor"},
+	}
+
+	if got := Lint("config.yml", []byte(src)); !cmp.Equal(got, want) {
+		t.Errorf("Lint got %v, want %v", got, want)
+	}
+}
+
+func TestLint_matrixRequires(t *testing.T) {
+	src := `version: 2.1
+jobs:
+  test:
+    machine: true
+    parameters:
+      go:
+        type: string
+    steps:
+      - checkout
+workflows:
+  main:
+    jobs:
+      - test:
+          matrix:
+            parameters:
+              go: ["1.20", "1.21"]
+      - test:
+          name: final
+          go: "1.21"
+          requires: [test-1.20]
+`
+
+	if got := Lint("config.yml", []byte(src)); len(got) != 0 {
+		t.Errorf("Lint got %v, want no problems", got)
+	}
+}
+
+func TestLint_matrixAlias(t *testing.T) {
+	src := `version: 2.1
+jobs:
+  test:
+    machine: true
+    parameters:
+      v:
+        type: string
+    steps:
+      - checkout
+workflows:
+  main:
+    jobs:
+      - test:
+          matrix:
+            alias: all-tests
+            parameters:
+              v: [a, b]
+      - test:
+          name: special-<< matrix.v >>
+          matrix:
+            parameters:
+              v: [c, d]
+      - test:
+          name: final
+          v: e
+          requires: [all-tests, special-c]
+  other:
+    jobs:
+      - test:
+          matrix:
+            parameters:
+              v: [a, b]
+      - test:
+          name: final
+          v: e
+          requires: [test]
+`
+
+	if got := Lint("config.yml", []byte(src)); len(got) != 0 {
+		t.Errorf("Lint got %v, want no problems", got)
+	}
+}
+
+func TestLint_parameterizedApproval(t *testing.T) {
+	src := `version: 2.1
+parameters:
+  ip-ranges:
+    type: boolean
+    default: false
+jobs:
+  test:
+    parameters:
+      parallelism:
+        type: integer
+        default: 2
+    docker:
+      - image: cimg/go:1.21
+    parallelism: << parameters.parallelism >>
+    circleci_ip_ranges: << pipeline.parameters.ip-ranges >>
+    steps:
+      - run:
+          command: ./server
+          background: << pipeline.parameters.ip-ranges >>
+workflows:
+  main:
+    jobs:
+      - hold:
+          type: approval
+      - test:
+          requires: [hold]
+      - undefined:
+          requires: [hold]
+`
+
+	want := []*Problem{
+		{Pos: Position{Filename: "config.yml", Line: 27, Column: 9}, Message: "job \"undefined\" is not defined"},
+	}
+
+	if got := Lint("config.yml", []byte(src)); !cmp.Equal(got, want) {
+		t.Errorf("Lint got %v, want %v", got, want)
+	}
+}
+
+func TestLint_yamlError(t *testing.T) {
+	src := "version: 2.1\njobs:\n  build:\n    steps: [\n"
+
+	got := Lint("config.yml", []byte(src))
+	if len(got) != 1 || got[0].Pos.Line == 0 {
+		t.Errorf("Lint got %v, want one problem with a line", got)
+	}
+}
@@ -0,0 +1,81 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_configs_Compile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/compile-config-with-defaults", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"config_yaml":"version: 2.1\n","options":{"owner_id":"org1","pipeline_parameters":"{\"deploy\":true}","pipeline_values":{"pipeline.git.branch":"main"}}}`+"\n")
+		fmt.Fprint(w, `{"valid": true, "source-yaml": "version: 2.1\n", "output-yaml": "version: 2\n", "errors": []}`)
+	})
+
+	ctx := context.Background()
+	cc, err := client.Configs.Compile(ctx, ConfigCompileOptions{
+		Config:             String("version: 2.1\n"),
+		OwnerID:            String("org1"),
+		PipelineParameters: map[string]interface{}{"deploy": true},
+		PipelineValues:     map[string]interface{}{"pipeline.git.branch": "main"},
+	})
+	if err != nil {
+		t.Errorf("Configs.Compile got error: %v", err)
+	}
+
+	want := &ConfigCompilation{
+		Valid: true,
+		Config: &PipelineConfig{
+			Source:   "version: 2.1\n",
+			Compiled: "version: 2\n",
+		},
+		Errors: ConfigErrors{},
+	}
+
+	if !cmp.Equal(cc, want) {
+		t.Errorf("Configs.Compile got %+v, want %+v", cc, want)
+	}
+}
+
+func Test_configs_Validate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/compile-config-with-defaults", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"config_yaml":"version: 2.1\n","options":{}}`+"\n")
+		fmt.Fprint(w, `{"valid": false, "source-yaml": "version: 2.1\n", "errors": [{"message": "expected type: Sequence, found: Mapping", "path": ["jobs", "build", "steps"]}, {"message": "no workflows"}]}`)
+	})
+
+	ctx := context.Background()
+	err := client.Configs.Validate(ctx, ConfigCompileOptions{
+		Config: String("version: 2.1\n"),
+	})
+
+	errs, ok := err.(ConfigErrors)
+	if !ok {
+		t.Fatalf("Configs.Validate got error %v, want ConfigErrors", err)
+	}
+
+	want := ConfigErrors{
+		{Message: "expected type: Sequence, found: Mapping", Path: []string{"jobs", "build", "steps"}},
+		{Message: "no workflows"},
+	}
+
+	if !cmp.Equal(errs, want) {
+		t.Errorf("Configs.Validate got %+v, want %+v", errs, want)
+	}
+
+	if got, want := err.Error(), "jobs.build.steps: expected type: Sequence, found: Mapping\nno workflows"; got != want {
+		t.Errorf("ConfigErrors.Error got %q, want %q", got, want)
+	}
+}
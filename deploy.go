@@ -0,0 +1,338 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Deploys exposes deploy tracking: the environments and components of an
+// organization, the versions of each component running in an environment,
+// and the release markers recording how they got there.
+type Deploys interface {
+	ListEnvironments(ctx context.Context, options DeployEnvironmentListOptions) (*DeployEnvironmentList, error)
+	GetEnvironment(ctx context.Context, environmentID string) (*DeployEnvironment, error)
+	ListComponents(ctx context.Context, options DeployComponentListOptions) (*DeployComponentList, error)
+	GetComponent(ctx context.Context, componentID string) (*DeployComponent, error)
+	ListComponentVersions(ctx context.Context, componentID string, options DeployComponentVersionListOptions) (*DeployComponentVersionList, error)
+	CreateRelease(ctx context.Context, options DeployReleaseCreateOptions) (*DeployRelease, error)
+	UpdateRelease(ctx context.Context, releaseID string, options DeployReleaseUpdateOptions) (*DeployRelease, error)
+}
+
+// deploys implements Deploys interface
+type deploys struct {
+	client *Client
+}
+
+type DeployReleaseTypeType string
+
+const (
+	DeployReleaseTypeRelease  DeployReleaseTypeType = "RELEASE"
+	DeployReleaseTypeRollback DeployReleaseTypeType = "ROLLBACK"
+)
+
+type DeployReleaseStatusType string
+
+const (
+	DeployReleaseStatusPending  DeployReleaseStatusType = "PENDING"
+	DeployReleaseStatusRunning  DeployReleaseStatusType = "RUNNING"
+	DeployReleaseStatusSuccess  DeployReleaseStatusType = "SUCCESS"
+	DeployReleaseStatusFailed   DeployReleaseStatusType = "FAILED"
+	DeployReleaseStatusCanceled DeployReleaseStatusType = "CANCELED"
+)
+
+type DeployEnvironment struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type DeployEnvironmentList struct {
+	Items         []*DeployEnvironment `json:"items"`
+	NextPageToken string               `json:"next_page_token"`
+}
+
+type DeployEnvironmentListOptions struct {
+	OrgID     *string `url:"org-id,omitempty"`
+	PageToken *string `url:"page-token,omitempty"`
+}
+
+func (o DeployEnvironmentListOptions) valid() error {
+	if !validString(o.OrgID) {
+		return ErrRequiredOrganizationID
+	}
+
+	return nil
+}
+
+func (s *deploys) ListEnvironments(ctx context.Context, options DeployEnvironmentListOptions) (*DeployEnvironmentList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "deploy/environments"
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	el := &DeployEnvironmentList{}
+	err = s.client.do(ctx, req, el)
+	if err != nil {
+		return nil, err
+	}
+
+	return el, nil
+}
+
+func (s *deploys) GetEnvironment(ctx context.Context, environmentID string) (*DeployEnvironment, error) {
+	if !validString(&environmentID) {
+		return nil, ErrRequiredDeployEnvironmentID
+	}
+
+	u := fmt.Sprintf("deploy/environments/%s", environmentID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &DeployEnvironment{}
+	err = s.client.do(ctx, req, e)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+type DeployComponent struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	ProjectID   string            `json:"project_id"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type DeployComponentList struct {
+	Items         []*DeployComponent `json:"items"`
+	NextPageToken string             `json:"next_page_token"`
+}
+
+type DeployComponentListOptions struct {
+	OrgID     *string `url:"org-id,omitempty"`
+	ProjectID *string `url:"project-id,omitempty"`
+	PageToken *string `url:"page-token,omitempty"`
+}
+
+func (o DeployComponentListOptions) valid() error {
+	if !validString(o.OrgID) {
+		return ErrRequiredOrganizationID
+	}
+
+	return nil
+}
+
+func (s *deploys) ListComponents(ctx context.Context, options DeployComponentListOptions) (*DeployComponentList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "deploy/components"
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	cl := &DeployComponentList{}
+	err = s.client.do(ctx, req, cl)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl, nil
+}
+
+func (s *deploys) GetComponent(ctx context.Context, componentID string) (*DeployComponent, error) {
+	if !validString(&componentID) {
+		return nil, ErrRequiredDeployComponentID
+	}
+
+	u := fmt.Sprintf("deploy/components/%s", componentID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &DeployComponent{}
+	err = s.client.do(ctx, req, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type DeployComponentVersion struct {
+	Name           string    `json:"name"`
+	Namespace      string    `json:"namespace"`
+	EnvironmentID  string    `json:"environment_id"`
+	IsLive         bool      `json:"is_live"`
+	PipelineID     string    `json:"pipeline_id"`
+	WorkflowID     string    `json:"workflow_id"`
+	JobID          string    `json:"job_id"`
+	JobNumber      int64     `json:"job_number"`
+	LastDeployedAt time.Time `json:"last_deployed_at"`
+}
+
+type DeployComponentVersionList struct {
+	Items         []*DeployComponentVersion `json:"items"`
+	NextPageToken string                    `json:"next_page_token"`
+}
+
+type DeployComponentVersionListOptions struct {
+	EnvironmentID *string `url:"environment-id,omitempty"`
+	PageToken     *string `url:"page-token,omitempty"`
+}
+
+func (o DeployComponentVersionListOptions) valid() error {
+	if !validString(o.EnvironmentID) {
+		return ErrRequiredDeployEnvironmentID
+	}
+
+	return nil
+}
+
+func (s *deploys) ListComponentVersions(ctx context.Context, componentID string, options DeployComponentVersionListOptions) (*DeployComponentVersionList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&componentID) {
+		return nil, ErrRequiredDeployComponentID
+	}
+
+	u := fmt.Sprintf("deploy/components/%s/versions", componentID)
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	vl := &DeployComponentVersionList{}
+	err = s.client.do(ctx, req, vl)
+	if err != nil {
+		return nil, err
+	}
+
+	return vl, nil
+}
+
+type DeployRelease struct {
+	ID              string                  `json:"id"`
+	Type            DeployReleaseTypeType   `json:"type"`
+	Status          DeployReleaseStatusType `json:"status"`
+	ComponentName   string                  `json:"component_name"`
+	EnvironmentName string                  `json:"environment_name"`
+	Namespace       string                  `json:"namespace"`
+	TargetVersion   string                  `json:"target_version"`
+	CurrentVersion  string                  `json:"current_version"`
+	PipelineID      string                  `json:"pipeline_id"`
+	WorkflowID      string                  `json:"workflow_id"`
+	JobID           string                  `json:"job_id"`
+	FailureReason   string                  `json:"failure_reason,omitempty"`
+	CreatedAt       time.Time               `json:"created_at"`
+	UpdatedAt       time.Time               `json:"updated_at"`
+}
+
+// DeployReleaseCreateOptions describes a release marker. When it is created
+// from within a job, WorkflowID and JobID are the values of the
+// CIRCLE_WORKFLOW_ID and CIRCLE_WORKFLOW_JOB_ID environment variables.
+type DeployReleaseCreateOptions struct {
+	ComponentName   *string                  `json:"component_name"`
+	EnvironmentName *string                  `json:"environment_name"`
+	TargetVersion   *string                  `json:"target_version"`
+	Namespace       *string                  `json:"namespace,omitempty"`
+	Type            *DeployReleaseTypeType   `json:"type,omitempty"`
+	Status          *DeployReleaseStatusType `json:"status,omitempty"`
+	PipelineID      *string                  `json:"pipeline_id,omitempty"`
+	WorkflowID      *string                  `json:"workflow_id,omitempty"`
+	JobID           *string                  `json:"job_id,omitempty"`
+}
+
+func (o DeployReleaseCreateOptions) valid() error {
+	if !validString(o.ComponentName) {
+		return ErrRequiredDeployComponentName
+	}
+
+	if !validString(o.EnvironmentName) {
+		return ErrRequiredDeployEnvironmentName
+	}
+
+	if !validString(o.TargetVersion) {
+		return ErrRequiredDeployTargetVersion
+	}
+
+	return nil
+}
+
+func (s *deploys) CreateRelease(ctx context.Context, options DeployReleaseCreateOptions) (*DeployRelease, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "deploy/releases"
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &DeployRelease{}
+	err = s.client.do(ctx, req, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+type DeployReleaseUpdateOptions struct {
+	Status        *DeployReleaseStatusType `json:"status"`
+	FailureReason *string                  `json:"failure_reason,omitempty"`
+}
+
+func (o DeployReleaseUpdateOptions) valid() error {
+	if o.Status == nil {
+		return ErrRequiredDeployReleaseStatus
+	}
+
+	return nil
+}
+
+func (s *deploys) UpdateRelease(ctx context.Context, releaseID string, options DeployReleaseUpdateOptions) (*DeployRelease, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&releaseID) {
+		return nil, ErrRequiredDeployReleaseID
+	}
+
+	u := fmt.Sprintf("deploy/releases/%s", releaseID)
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &DeployRelease{}
+	err = s.client.do(ctx, req, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
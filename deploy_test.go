@@ -0,0 +1,257 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_deploys_ListEnvironments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+
+	mux.HandleFunc("/deploy/environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "org-id", orgID)
+		testQuery(t, r, "page-token", "token1")
+		fmt.Fprint(w, `{"items": [{"id": "env1", "name": "production", "labels": {"region": "us-east-1"}}], "next_page_token": "token2"}`)
+	})
+
+	ctx := context.Background()
+	el, err := client.Deploys.ListEnvironments(ctx, DeployEnvironmentListOptions{
+		OrgID:     String(orgID),
+		PageToken: String("token1"),
+	})
+	if err != nil {
+		t.Errorf("Deploys.ListEnvironments got error: %v", err)
+	}
+
+	want := &DeployEnvironmentList{
+		Items: []*DeployEnvironment{
+			{
+				ID:     "env1",
+				Name:   "production",
+				Labels: map[string]string{"region": "us-east-1"},
+			},
+		},
+		NextPageToken: "token2",
+	}
+
+	if !cmp.Equal(el, want) {
+		t.Errorf("Deploys.ListEnvironments got %+v, want %+v", el, want)
+	}
+}
+
+func Test_deploys_GetEnvironment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	environmentID := "env1"
+
+	mux.HandleFunc(fmt.Sprintf("/deploy/environments/%s", environmentID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"id": "env1", "name": "production"}`)
+	})
+
+	ctx := context.Background()
+	e, err := client.Deploys.GetEnvironment(ctx, environmentID)
+	if err != nil {
+		t.Errorf("Deploys.GetEnvironment got error: %v", err)
+	}
+
+	want := &DeployEnvironment{
+		ID:   environmentID,
+		Name: "production",
+	}
+
+	if !cmp.Equal(e, want) {
+		t.Errorf("Deploys.GetEnvironment got %+v, want %+v", e, want)
+	}
+}
+
+func Test_deploys_ListComponents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+
+	mux.HandleFunc("/deploy/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "org-id", orgID)
+		fmt.Fprint(w, `{"items": [{"id": "component1", "name": "api", "project_id": "project1"}]}`)
+	})
+
+	ctx := context.Background()
+	cl, err := client.Deploys.ListComponents(ctx, DeployComponentListOptions{
+		OrgID: String(orgID),
+	})
+	if err != nil {
+		t.Errorf("Deploys.ListComponents got error: %v", err)
+	}
+
+	want := &DeployComponentList{
+		Items: []*DeployComponent{
+			{
+				ID:        "component1",
+				Name:      "api",
+				ProjectID: "project1",
+			},
+		},
+	}
+
+	if !cmp.Equal(cl, want) {
+		t.Errorf("Deploys.ListComponents got %+v, want %+v", cl, want)
+	}
+}
+
+func Test_deploys_GetComponent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	componentID := "component1"
+
+	mux.HandleFunc(fmt.Sprintf("/deploy/components/%s", componentID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"id": "component1", "name": "api"}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.Deploys.GetComponent(ctx, componentID)
+	if err != nil {
+		t.Errorf("Deploys.GetComponent got error: %v", err)
+	}
+
+	want := &DeployComponent{
+		ID:   componentID,
+		Name: "api",
+	}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("Deploys.GetComponent got %+v, want %+v", c, want)
+	}
+}
+
+func Test_deploys_ListComponentVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	componentID := "component1"
+	environmentID := "env1"
+
+	mux.HandleFunc(fmt.Sprintf("/deploy/components/%s/versions", componentID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "environment-id", environmentID)
+		fmt.Fprint(w, `{"items": [{"name": "v1.2.0", "environment_id": "env1", "is_live": true, "job_number": 42}]}`)
+	})
+
+	ctx := context.Background()
+	vl, err := client.Deploys.ListComponentVersions(ctx, componentID, DeployComponentVersionListOptions{
+		EnvironmentID: String(environmentID),
+	})
+	if err != nil {
+		t.Errorf("Deploys.ListComponentVersions got error: %v", err)
+	}
+
+	want := &DeployComponentVersionList{
+		Items: []*DeployComponentVersion{
+			{
+				Name:          "v1.2.0",
+				EnvironmentID: environmentID,
+				IsLive:        true,
+				JobNumber:     42,
+			},
+		},
+	}
+
+	if !cmp.Equal(vl, want) {
+		t.Errorf("Deploys.ListComponentVersions got %+v, want %+v", vl, want)
+	}
+}
+
+func Test_deploys_CreateRelease(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/deploy/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"component_name":"api","environment_name":"production","target_version":"v1.2.0","status":"PENDING","workflow_id":"workflow1","job_id":"job1"}`+"\n")
+		fmt.Fprint(w, `{"id": "release1", "type": "RELEASE", "status": "PENDING", "component_name": "api", "environment_name": "production", "target_version": "v1.2.0"}`)
+	})
+
+	ctx := context.Background()
+	r, err := client.Deploys.CreateRelease(ctx, DeployReleaseCreateOptions{
+		ComponentName:   String("api"),
+		EnvironmentName: String("production"),
+		TargetVersion:   String("v1.2.0"),
+		Status:          DeployReleaseStatus(DeployReleaseStatusPending),
+		WorkflowID:      String("workflow1"),
+		JobID:           String("job1"),
+	})
+	if err != nil {
+		t.Errorf("Deploys.CreateRelease got error: %v", err)
+	}
+
+	want := &DeployRelease{
+		ID:              "release1",
+		Type:            DeployReleaseTypeRelease,
+		Status:          DeployReleaseStatusPending,
+		ComponentName:   "api",
+		EnvironmentName: "production",
+		TargetVersion:   "v1.2.0",
+	}
+
+	if !cmp.Equal(r, want) {
+		t.Errorf("Deploys.CreateRelease got %+v, want %+v", r, want)
+	}
+}
+
+func Test_deploys_UpdateRelease(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	releaseID := "release1"
+
+	mux.HandleFunc(fmt.Sprintf("/deploy/releases/%s", releaseID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"status":"FAILED","failure_reason":"health check failed"}`+"\n")
+		fmt.Fprint(w, `{"id": "release1", "status": "FAILED", "failure_reason": "health check failed"}`)
+	})
+
+	ctx := context.Background()
+	r, err := client.Deploys.UpdateRelease(ctx, releaseID, DeployReleaseUpdateOptions{
+		Status:        DeployReleaseStatus(DeployReleaseStatusFailed),
+		FailureReason: String("health check failed"),
+	})
+	if err != nil {
+		t.Errorf("Deploys.UpdateRelease got error: %v", err)
+	}
+
+	want := &DeployRelease{
+		ID:            releaseID,
+		Status:        DeployReleaseStatusFailed,
+		FailureReason: "health check failed",
+	}
+
+	if !cmp.Equal(r, want) {
+		t.Errorf("Deploys.UpdateRelease got %+v, want %+v", r, want)
+	}
+}
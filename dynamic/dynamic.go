@@ -0,0 +1,361 @@
+// Package dynamic generates continuation configs for setup workflows. It maps
+// the paths changed by a commit range to pipeline parameters and config
+// fragments, merges the fragments into a single config and continues the
+// pipeline with it.
+package dynamic
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/grezar/go-circleci"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrRequiredContinuationKey = errors.New("CIRCLE_CONTINUATION_KEY is not set, continuation must run in a setup workflow")
+	ErrRequiredPattern         = errors.New("mapping pattern is required")
+	ErrRequiredBaseRef         = errors.New("base ref is required")
+)
+
+// Continuer continues pipelines. circleci.Pipelines satisfies this interface.
+type Continuer interface {
+	Continue(ctx context.Context, options circleci.PipelineContinueOptions) error
+}
+
+// Mapping selects what to run when any changed path matches Pattern.
+type Mapping struct {
+	// Pattern is matched against each changed path with MatchString, so it
+	// should be anchored to match whole paths.
+	Pattern *regexp.Regexp
+	// Parameter is the pipeline parameter set to Value. It may be empty
+	// when the mapping only selects a config fragment.
+	Parameter string
+	Value     interface{}
+	// Config is the path of a config fragment merged into the continuation
+	// config. It may be empty.
+	Config string
+}
+
+// ParseMappings parses mappings in the format of the path-filtering orb, one
+// per line:
+//
+//	<path regexp> <parameter> <value> [<config fragment path>]
+//
+// Patterns must match whole paths. Values are decoded as JSON and fall back
+// to strings. Blank lines and lines starting with # are ignored.
+func ParseMappings(s string) ([]*Mapping, error) {
+	var mappings []*Mapping
+	sc := bufio.NewScanner(strings.NewReader(s))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 && len(fields) != 4 {
+			return nil, fmt.Errorf("line %d: expected <path regexp> <parameter> <value> [<config path>]", n)
+		}
+
+		re, err := regexp.Compile("^(?:" + fields[0] + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		var value interface{}
+		if err := json.Unmarshal([]byte(fields[2]), &value); err != nil {
+			value = fields[2]
+		}
+
+		m := &Mapping{Pattern: re, Parameter: fields[1], Value: value}
+		if len(fields) == 4 {
+			m.Config = fields[3]
+		}
+		mappings = append(mappings, m)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
+
+type Generator struct {
+	Mappings []*Mapping
+	// Base is the YAML of the config that fragments are merged into. When
+	// nil, generation starts from an empty 2.1 config.
+	Base []byte
+	// ReadFile reads config fragments. It defaults to os.ReadFile.
+	ReadFile func(name string) ([]byte, error)
+}
+
+// Continuation is the config and parameters a pipeline is continued with.
+type Continuation struct {
+	// Config is the merged config document. Configs are merged as YAML
+	// nodes, so everything in them is kept as written.
+	Config     *yaml.Node
+	Parameters map[string]interface{}
+}
+
+// YAML returns the continuation config as YAML.
+func (c *Continuation) YAML() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(c.Config); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Generate builds the continuation for the given changed paths.
+func (g *Generator) Generate(changed []string) (*Continuation, error) {
+	readFile := g.ReadFile
+	if readFile == nil {
+		readFile = os.ReadFile
+	}
+
+	params := map[string]interface{}{}
+	var fragments []string
+	for _, m := range g.Mappings {
+		if m.Pattern == nil {
+			return nil, ErrRequiredPattern
+		}
+		if !matchAny(m.Pattern, changed) {
+			continue
+		}
+		if m.Parameter != "" {
+			params[m.Parameter] = m.Value
+		}
+		if m.Config != "" && !contains(fragments, m.Config) {
+			fragments = append(fragments, m.Config)
+		}
+	}
+
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	if g.Base != nil {
+		base, err := parseMapping(g.Base)
+		if err != nil {
+			return nil, fmt.Errorf("base config: %v", err)
+		}
+		if err := merge(root, base); err != nil {
+			return nil, fmt.Errorf("base config: %v", err)
+		}
+	}
+
+	for _, name := range fragments {
+		b, err := readFile(name)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parseMapping(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if err := merge(root, f); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	// A continuation config can't itself be a setup config.
+	removeKey(root, "setup")
+	if mappingValue(root, "version") == nil {
+		root.Content = append([]*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: "version"},
+			{Kind: yaml.ScalarNode, Tag: "!!float", Value: "2.1"},
+		}, root.Content...)
+	}
+
+	// CircleCI rejects parameters the config does not declare, so catch
+	// them before continuing.
+	declared := mappingValue(root, "parameters")
+	for name := range params {
+		if declared == nil || mappingValue(declared, name) == nil {
+			return nil, fmt.Errorf("parameter %q is not declared in the continuation config", name)
+		}
+	}
+
+	return &Continuation{
+		Config:     &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}},
+		Parameters: params,
+	}, nil
+}
+
+// Continue continues the running pipeline with c. It must run in a job of a
+// setup workflow, where CircleCI sets CIRCLE_CONTINUATION_KEY.
+func Continue(ctx context.Context, continuer Continuer, c *Continuation) error {
+	key := getenv("CIRCLE_CONTINUATION_KEY")
+	if key == "" {
+		return ErrRequiredContinuationKey
+	}
+
+	b, err := c.YAML()
+	if err != nil {
+		return err
+	}
+
+	options := circleci.PipelineContinueOptions{
+		ContinuationKey: circleci.String(key),
+		Configuration:   circleci.String(string(b)),
+	}
+	if len(c.Parameters) > 0 {
+		options.Parameters = c.Parameters
+	}
+
+	return continuer.Continue(ctx, options)
+}
+
+var getenv = os.Getenv
+
+// sections are the top-level keys of a config whose entries are merged one
+// by one. Any other top-level key is merged as a whole.
+var sections = []string{"orbs", "parameters", "executors", "commands", "jobs", "workflows"}
+
+// parseMapping parses a config into its top-level mapping node.
+func parseMapping(b []byte) (*yaml.Node, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(b, doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode}, nil
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("config must be a map")
+	}
+	return resolveAliases(doc.Content[0]), nil
+}
+
+// resolveAliases replaces the aliases under n with copies of their anchored
+// nodes. Merging reorders keys and mixes documents, which would otherwise
+// leave aliases pointing at anchors defined after them or in another file.
+func resolveAliases(n *yaml.Node) *yaml.Node {
+	if n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	c := *n
+	c.Anchor = ""
+	if len(n.Content) > 0 {
+		c.Content = make([]*yaml.Node, len(n.Content))
+		for i, child := range n.Content {
+			c.Content[i] = resolveAliases(child)
+		}
+	}
+	return &c
+}
+
+// merge adds the top-level keys of the mapping src to dst. Definitions
+// present in both must be identical, except for the versions, of which the
+// first one wins.
+func merge(dst, src *yaml.Node) error {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		k, v := src.Content[i], src.Content[i+1]
+		existing := mappingValue(dst, k.Value)
+		switch {
+		case existing == nil:
+			dst.Content = append(dst.Content, k, v)
+		case k.Value == "version":
+		case contains(sections, k.Value):
+			if err := mergeSection(strings.TrimSuffix(k.Value, "s"), existing, v); err != nil {
+				return err
+			}
+		default:
+			if err := mergeValue(fmt.Sprintf("key %q", k.Value), existing, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeSection adds the entries of the mapping src to dst.
+func mergeSection(what string, dst, src *yaml.Node) error {
+	if src.Tag == "!!null" {
+		return nil
+	}
+	if dst.Kind != yaml.MappingNode || src.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: %ss must be a map", src.Line, what)
+	}
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		k, v := src.Content[i], src.Content[i+1]
+		existing := mappingValue(dst, k.Value)
+		switch {
+		case existing == nil:
+			dst.Content = append(dst.Content, k, v)
+		case what == "workflow" && k.Value == "version":
+		default:
+			if err := mergeValue(fmt.Sprintf("%s %q", what, k.Value), existing, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeValue checks that two definitions of the same thing are identical.
+func mergeValue(what string, existing, v *yaml.Node) error {
+	var a, b interface{}
+	if err := existing.Decode(&a); err != nil {
+		return err
+	}
+	if err := v.Decode(&b); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(a, b) {
+		return fmt.Errorf("conflicting definitions of %s", what)
+	}
+	return nil
+}
+
+// mappingValue returns the value of key in the mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func removeKey(node *yaml.Node, key string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+func matchAny(re *regexp.Regexp, paths []string) bool {
+	for _, p := range paths {
+		if re.MatchString(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
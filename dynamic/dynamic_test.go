@@ -0,0 +1,330 @@
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grezar/go-circleci"
+	"github.com/grezar/go-circleci/config"
+	"gopkg.in/yaml.v3"
+)
+
+type fakeContinuer struct {
+	options *circleci.PipelineContinueOptions
+}
+
+func (f *fakeContinuer) Continue(ctx context.Context, options circleci.PipelineContinueOptions) error {
+	f.options = &options
+	return nil
+}
+
+var _ Continuer = circleci.Pipelines(nil)
+
+func TestParseMappings(t *testing.T) {
+	ms, err := ParseMappings(`
+# services
+api/.* run-api true .circleci/api.yml
+web/.*  target "web"
+`)
+	if err != nil {
+		t.Fatalf("ParseMappings got error: %v", err)
+	}
+
+	if len(ms) != 2 {
+		t.Fatalf("ParseMappings got %d mappings, want 2", len(ms))
+	}
+
+	if got, want := ms[0].Pattern.String(), "^(?:api/.*)$"; got != want {
+		t.Errorf("Mapping.Pattern got %q, want %q", got, want)
+	}
+	if ms[0].Parameter != "run-api" || ms[0].Value != true || ms[0].Config != ".circleci/api.yml" {
+		t.Errorf("ParseMappings got %+v", ms[0])
+	}
+	if ms[1].Value != "web" || ms[1].Config != "" {
+		t.Errorf("ParseMappings got %+v", ms[1])
+	}
+
+	if _, err := ParseMappings("api/.* run-api"); err == nil {
+		t.Errorf("ParseMappings got no error for a malformed line")
+	}
+}
+
+var fragments = map[string]string{
+	"api.yml": `version: 2.1
+jobs:
+  test-api:
+    docker:
+      - image: cimg/go:1.21
+    steps:
+      - checkout
+workflows:
+  api:
+    jobs:
+      - test-api
+`,
+	"web.yml": `version: 2.1
+jobs:
+  test-web:
+    docker:
+      - image: cimg/node:20.0
+    steps:
+      - checkout
+workflows:
+  web:
+    jobs:
+      - test-web
+`,
+}
+
+func readFragment(name string) ([]byte, error) {
+	s, ok := fragments[name]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", name)
+	}
+	return []byte(s), nil
+}
+
+func TestGenerator_Generate(t *testing.T) {
+	base := []byte(`version: 2.1
+setup: true
+parameters:
+  run-api:
+    type: boolean
+    default: false
+  run-web:
+    type: boolean
+    default: false
+`)
+
+	g := &Generator{
+		Mappings: []*Mapping{
+			{Pattern: regexp.MustCompile(`^api/`), Parameter: "run-api", Value: true, Config: "api.yml"},
+			{Pattern: regexp.MustCompile(`^web/`), Parameter: "run-web", Value: true, Config: "web.yml"},
+			{Pattern: regexp.MustCompile(`^go\.mod$`), Parameter: "run-api", Value: true, Config: "api.yml"},
+		},
+		Base:     base,
+		ReadFile: readFragment,
+	}
+
+	c, err := g.Generate([]string{"api/main.go", "go.mod", "README.md"})
+	if err != nil {
+		t.Fatalf("Generator.Generate got error: %v", err)
+	}
+
+	if want := map[string]interface{}{"run-api": true}; !cmp.Equal(c.Parameters, want) {
+		t.Errorf("Continuation.Parameters got %+v, want %+v", c.Parameters, want)
+	}
+
+	b, err := c.YAML()
+	if err != nil {
+		t.Fatalf("Continuation.YAML got error: %v", err)
+	}
+	got, err := config.Parse(b)
+	if err != nil {
+		t.Fatalf("Parse of the continuation config got error: %v\n%s", err, b)
+	}
+
+	if got.Version != "2.1" {
+		t.Errorf("Continuation.Config has version %q, want 2.1", got.Version)
+	}
+	if got.Setup {
+		t.Errorf("Continuation.Config is a setup config")
+	}
+	if _, ok := got.Jobs["test-api"]; !ok {
+		t.Errorf("Continuation.Config is missing job test-api")
+	}
+	if _, ok := got.Jobs["test-web"]; ok {
+		t.Errorf("Continuation.Config has unexpected job test-web")
+	}
+}
+
+func TestGenerator_Generate_keepsFragments(t *testing.T) {
+	fragments["parameterized.yml"] = `version: 2.1
+x-image: &image cimg/go:1.21
+jobs:
+  test-api:
+    docker:
+      - image: cimg/go:1.21
+    steps:
+      - checkout
+  lint:
+    description: Runs the linters
+    parameters:
+      parallelism:
+        type: integer
+        default: 2
+    docker:
+      - image: *image
+    parallelism: << parameters.parallelism >>
+    steps:
+      - run:
+          command: make lint
+          background: << pipeline.parameters.run-api >>
+workflows:
+  api:
+    jobs:
+      - test-api
+  lint:
+    max_auto_reruns: 3
+    jobs:
+      - lint
+`
+	defer delete(fragments, "parameterized.yml")
+
+	g := &Generator{
+		Mappings: []*Mapping{
+			{Pattern: regexp.MustCompile(`.*`), Config: "api.yml"},
+			{Pattern: regexp.MustCompile(`.*`), Config: "parameterized.yml"},
+		},
+		ReadFile: readFragment,
+	}
+
+	c, err := g.Generate([]string{"a"})
+	if err != nil {
+		t.Fatalf("Generator.Generate got error: %v", err)
+	}
+	b, err := c.YAML()
+	if err != nil {
+		t.Fatalf("Continuation.YAML got error: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := yaml.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal of the continuation config got error: %v\n%s", err, b)
+	}
+
+	lint := got["jobs"].(map[string]interface{})["lint"].(map[string]interface{})
+	if lint["description"] != "Runs the linters" || lint["parallelism"] != "<< parameters.parallelism >>" {
+		t.Errorf("Continuation.Config changed job lint: %v", lint)
+	}
+	if image := lint["docker"].([]interface{})[0].(map[string]interface{})["image"]; image != "cimg/go:1.21" {
+		t.Errorf("Continuation.Config resolved the lint image to %v", image)
+	}
+	if got["workflows"].(map[string]interface{})["lint"].(map[string]interface{})["max_auto_reruns"] != 3 {
+		t.Errorf("Continuation.Config lost max_auto_reruns of workflow lint:\n%s", b)
+	}
+}
+
+func TestGenerator_Generate_errors(t *testing.T) {
+	g := &Generator{
+		Mappings: []*Mapping{
+			{Pattern: regexp.MustCompile(`.*`), Parameter: "run-api", Value: true},
+		},
+	}
+	if _, err := g.Generate([]string{"a"}); err == nil {
+		t.Errorf("Generator.Generate got no error for an undeclared parameter")
+	}
+
+	fragments["conflict.yml"] = `version: 2.1
+jobs:
+  test-api:
+    machine: true
+    steps:
+      - checkout
+`
+	defer delete(fragments, "conflict.yml")
+
+	g = &Generator{
+		Mappings: []*Mapping{
+			{Pattern: regexp.MustCompile(`.*`), Config: "api.yml"},
+			{Pattern: regexp.MustCompile(`.*`), Config: "conflict.yml"},
+		},
+		ReadFile: readFragment,
+	}
+	if _, err := g.Generate([]string{"a"}); err == nil {
+		t.Errorf("Generator.Generate got no error for conflicting jobs")
+	}
+}
+
+func TestContinue(t *testing.T) {
+	defer func() { getenv = os.Getenv }()
+
+	c := &Continuation{
+		Config:     &yaml.Node{},
+		Parameters: map[string]interface{}{"run-api": true},
+	}
+
+	if err := yaml.Unmarshal([]byte("version: 2.1\n"), c.Config); err != nil {
+		t.Fatal(err)
+	}
+
+	getenv = func(string) string { return "" }
+	if err := Continue(context.Background(), &fakeContinuer{}, c); err != ErrRequiredContinuationKey {
+		t.Errorf("Continue got error %v, want %v", err, ErrRequiredContinuationKey)
+	}
+
+	getenv = func(key string) string {
+		if key == "CIRCLE_CONTINUATION_KEY" {
+			return "key1"
+		}
+		return ""
+	}
+	f := &fakeContinuer{}
+	if err := Continue(context.Background(), f, c); err != nil {
+		t.Fatalf("Continue got error: %v", err)
+	}
+
+	want := &circleci.PipelineContinueOptions{
+		ContinuationKey: circleci.String("key1"),
+		Configuration:   circleci.String("version: 2.1\n"),
+		Parameters:      map[string]interface{}{"run-api": true},
+	}
+	if !cmp.Equal(f.options, want) {
+		t.Errorf("Continue got %+v, want %+v", f.options, want)
+	}
+}
+
+func TestChangedFiles(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	write := func(name string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	git("init", "-q", "-b", "main")
+	write("README.md")
+	git("add", "-A")
+	git("commit", "-q", "-m", "initial")
+	git("checkout", "-q", "-b", "feature")
+	write("api/main.go")
+	write("web/index.js")
+	git("add", "-A")
+	git("commit", "-q", "-m", "change")
+
+	paths, err := ChangedFiles(context.Background(), dir, "main", "")
+	if err != nil {
+		t.Fatalf("ChangedFiles got error: %v", err)
+	}
+
+	if want := []string{"api/main.go", "web/index.js"}; !cmp.Equal(paths, want) {
+		t.Errorf("ChangedFiles got %v, want %v", paths, want)
+	}
+
+	if _, err := ChangedFiles(context.Background(), dir, "", ""); err != ErrRequiredBaseRef {
+		t.Errorf("ChangedFiles got error %v, want %v", err, ErrRequiredBaseRef)
+	}
+}
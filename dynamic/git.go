@@ -0,0 +1,39 @@
+package dynamic
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ChangedFiles returns the paths changed between the merge base of base and
+// head, and head, as the path-filtering orb does. dir is the repository
+// checkout and head defaults to HEAD.
+func ChangedFiles(ctx context.Context, dir, base, head string) ([]string, error) {
+	if base == "" {
+		return nil, ErrRequiredBaseRef
+	}
+
+	if head == "" {
+		head = "HEAD"
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", "diff", "--name-only", "--no-renames", base+"..."+head)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git diff: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var paths []string
+	for _, p := range strings.Split(stdout.String(), "\n") {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths, nil
+}
@@ -33,4 +33,58 @@ var (
 	ErrRequiredWebhookSigningSecret          = errors.New("webhook signingSecret is required")
 	ErrRequiredWebhookScopeID                = errors.New("webhook scopeID is required")
 	ErrRequiredWebhookScopeType              = errors.New("webhook scopeType is required")
+
+	ErrRequiredOrganizationID                       = errors.New("organization ID is required")
+	ErrRequiredOrganizationSlug                     = errors.New("organization slug is required")
+	ErrRequiredProjectID                            = errors.New("project ID is required")
+	ErrRequiredProjectProvider                      = errors.New("project provider is required")
+	ErrRequiredProjectOrganization                  = errors.New("project organization is required")
+	ErrRequiredProjectName                          = errors.New("project name is required")
+	ErrNoProjectPipelines                           = errors.New("project has no pipelines to read the config from")
+	ErrRequiredConfigYAML                           = errors.New("config YAML is required")
+	ErrRequiredPipelineDefinitionID                 = errors.New("pipeline definition ID is required")
+	ErrRequiredPipelineDefinitionName               = errors.New("pipeline definition name is required")
+	ErrRequiredPipelineDefinitionConfigSource       = errors.New("pipeline definition config source is required")
+	ErrRequiredPipelineDefinitionCheckoutSource     = errors.New("pipeline definition checkout source is required")
+	ErrRequiredPipelineTriggerID                    = errors.New("pipeline trigger ID is required")
+	ErrRequiredPipelineTriggerEventSource           = errors.New("pipeline trigger event source is required")
+	ErrRequiredJobID                                = errors.New("job ID is required")
+	ErrRequiredInsightsStartDate                    = errors.New("insights start date is required")
+	ErrInvalidInsightsStartDate                     = errors.New("insights start date is older than the data retention period")
+	ErrInvalidInsightsDateRange                     = errors.New("insights date range is invalid")
+	ErrInvalidInsightsGranularity                   = errors.New("insights granularity is invalid")
+	ErrRequiredSearchPattern                        = errors.New("search pattern is required")
+	ErrRequiredOIDCClaims                           = errors.New("at least one OIDC claim is required")
+	ErrRequiredOwnerID                              = errors.New("owner ID is required")
+	ErrRequiredPolicies                             = errors.New("policies are required")
+	ErrRequiredPolicyDecisionID                     = errors.New("policy decision ID is required")
+	ErrRequiredPolicyDecisionEnabled                = errors.New("policy decision enabled is required")
+	ErrRequiredUsageExportJobID                     = errors.New("usage export job ID is required")
+	ErrRequiredUsageExportStart                     = errors.New("usage export start is required")
+	ErrRequiredUsageExportEnd                       = errors.New("usage export end is required")
+	ErrInvalidUsageExportRange                      = errors.New("usage export end must be after start")
+	ErrUsageExportJobFailed                         = errors.New("usage export job failed")
+	ErrUsageExportJobNotCompleted                   = errors.New("usage export job is not completed")
+	ErrRequiredDeployEnvironmentID                  = errors.New("deploy environment ID is required")
+	ErrRequiredDeployEnvironmentName                = errors.New("deploy environment name is required")
+	ErrRequiredDeployComponentID                    = errors.New("deploy component ID is required")
+	ErrRequiredDeployComponentName                  = errors.New("deploy component name is required")
+	ErrRequiredDeployTargetVersion                  = errors.New("deploy target version is required")
+	ErrRequiredDeployReleaseID                      = errors.New("deploy release ID is required")
+	ErrRequiredDeployReleaseStatus                  = errors.New("deploy release status is required")
+	ErrRequiredOrbNamespace                         = errors.New("orb namespace is required")
+	ErrRequiredOrbName                              = errors.New("orb name is required")
+	ErrRequiredOrbRef                               = errors.New("orb reference is required")
+	ErrRequiredOrbVersion                           = errors.New("orb version is required")
+	ErrInvalidOrbVersion                            = errors.New("orb version must be a semantic version or dev:<label>")
+	ErrRequiredOrbSource                            = errors.New("orb source is required")
+	ErrRequiredRunnerResourceClass                  = errors.New("runner resource class is required")
+	ErrRequiredRunnerResourceClassID                = errors.New("runner resource class ID is required")
+	ErrRequiredRunnerResourceClassDescription       = errors.New("runner resource class description is required")
+	ErrRequiredRunnerTokenID                        = errors.New("runner token ID is required")
+	ErrRequiredRunnerTokenNickname                  = errors.New("runner token nickname is required")
+	ErrRequiredEitherRunnerResourceClassOrNamespace = errors.New("either runner resource class or namespace is required")
+	ErrPipelineErrored                              = errors.New("pipeline errored")
+	ErrWaitTimeout                                  = errors.New("timed out waiting for completion")
+	ErrInvalidWatchCursor                           = errors.New("watch cursor is invalid")
 )
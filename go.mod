@@ -6,4 +6,5 @@ require (
 	github.com/golang/mock v1.6.0
 	github.com/google/go-cmp v0.6.0
 	github.com/google/go-querystring v1.1.0
+	gopkg.in/yaml.v3 v3.0.1
 )
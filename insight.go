@@ -4,6 +4,7 @@ package circleci
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -13,6 +14,13 @@ type Insights interface {
 	GetTestMetricsForWorkflows(ctx context.Context, projectSlug, workflowName string, options InsightsGetTestMetricsOptions) (*TestMetrics, error)
 	ListWorkflowRuns(ctx context.Context, projectSlug, workflowName string, options InsightsListWorkflowRunsOptions) (*WorkflowRunList, error)
 	ListWorkflowJobRuns(ctx context.Context, projectSlug, workflowName, jobName string, options InsightsListWorkflowRunsOptions) (*WorkflowRunList, error)
+	GetProjectSummary(ctx context.Context, projectSlug string, options InsightsGetProjectSummaryOptions) (*ProjectSummary, error)
+	ListBranches(ctx context.Context, projectSlug string, options InsightsListBranchesOptions) (*InsightsBranches, error)
+	GetFlakyTests(ctx context.Context, projectSlug string) (*FlakyTests, error)
+	GetOrgSummary(ctx context.Context, orgSlug string, options InsightsGetOrgSummaryOptions) (*OrgSummary, error)
+	ListJobTimeSeries(ctx context.Context, projectSlug, workflowName string, options InsightsListJobTimeSeriesOptions) (*JobTimeSeriesList, error)
+	ListSummaryMetricsForWorkflowsInRange(ctx context.Context, projectSlug string, options InsightsListSummaryMetricsInRangeOptions) (*RangeSummaryMetricsList, error)
+	ListSummaryMetricsForWorkflowJobsInRange(ctx context.Context, projectSlug, workflowName string, options InsightsListSummaryMetricsInRangeOptions) (*RangeSummaryMetricsList, error)
 }
 
 // insights implementes Insights interface
@@ -30,6 +38,52 @@ const (
 	Last24Hours ReportingWindowType = "last-24-hours"
 )
 
+type GranularityType string
+
+const (
+	GranularityHourly GranularityType = "hourly"
+	GranularityDaily  GranularityType = "daily"
+)
+
+// insightsRetention is how far back in time Insights data is kept.
+const insightsRetention = 90 * 24 * time.Hour
+
+// maxInsightsRunsRange is the longest range accepted by the workflow and job
+// runs endpoints. It is replaced in tests.
+var maxInsightsRunsRange = 90 * 24 * time.Hour
+
+// maxInsightsTimeSeriesRange is the longest range the time-series endpoint
+// accepts in a single request for each granularity. Longer ranges are split
+// into several requests.
+var maxInsightsTimeSeriesRange = map[GranularityType]time.Duration{
+	GranularityHourly: 48 * time.Hour,
+	GranularityDaily:  90 * 24 * time.Hour,
+}
+
+// timeNow is replaced in tests.
+var timeNow = time.Now
+
+func validInsightsRange(start, end *time.Time) error {
+	if end != nil && start == nil {
+		return ErrRequiredInsightsStartDate
+	}
+
+	if start != nil && end != nil && !end.After(*start) {
+		return ErrInvalidInsightsDateRange
+	}
+
+	return nil
+}
+
+func validGranularity(v *GranularityType) bool {
+	if v == nil {
+		return true
+	}
+
+	_, ok := maxInsightsTimeSeriesRange[*v]
+	return ok
+}
+
 type SummaryMetricsList struct {
 	Items         []*SummaryMetrics `json:"items"`
 	NextPageToken string            `json:"next_page_token"`
@@ -295,3 +349,623 @@ func (s *insights) ListWorkflowJobRuns(ctx context.Context, projectSlug, workflo
 
 	return wrl, nil
 }
+
+type ProjectSummary struct {
+	OrgID                     string                          `json:"org_id"`
+	ProjectID                 string                          `json:"project_id"`
+	ProjectData               *ProjectSummaryData             `json:"project_data"`
+	ProjectWorkflowData       []*ProjectWorkflowSummary       `json:"project_workflow_data"`
+	ProjectWorkflowBranchData []*ProjectWorkflowBranchSummary `json:"project_workflow_branch_data"`
+	AllBranches               []string                        `json:"all_branches"`
+	AllWorkflows              []string                        `json:"all_workflows"`
+}
+
+type ProjectSummaryData struct {
+	Metrics *SummaryAggregateMetrics `json:"metrics"`
+	Trends  *SummaryAggregateMetrics `json:"trends"`
+}
+
+// SummaryAggregateMetrics holds the aggregated metrics of a summary page.
+// When used as trends, each value is the ratio of change compared to the
+// previous reporting window.
+type SummaryAggregateMetrics struct {
+	TotalRuns         float64 `json:"total_runs"`
+	TotalDurationSecs float64 `json:"total_duration_secs"`
+	TotalCreditsUsed  float64 `json:"total_credits_used"`
+	SuccessRate       float64 `json:"success_rate"`
+	Throughput        float64 `json:"throughput"`
+	P95DurationSecs   float64 `json:"p95_duration_secs"`
+}
+
+type ProjectWorkflowSummary struct {
+	WorkflowName string                   `json:"workflow_name"`
+	Metrics      *SummaryAggregateMetrics `json:"metrics"`
+	Trends       *SummaryAggregateMetrics `json:"trends"`
+}
+
+type ProjectWorkflowBranchSummary struct {
+	WorkflowName string                   `json:"workflow_name"`
+	Branch       string                   `json:"branch"`
+	Metrics      *SummaryAggregateMetrics `json:"metrics"`
+	Trends       *SummaryAggregateMetrics `json:"trends"`
+}
+
+type InsightsGetProjectSummaryOptions struct {
+	ReportingWindow *ReportingWindowType `url:"reporting-window,omitempty"`
+	Branches        []string             `url:"branches,omitempty"`
+	WorkflowNames   []string             `url:"workflow-names,omitempty"`
+}
+
+func (o InsightsGetProjectSummaryOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+func (s *insights) GetProjectSummary(ctx context.Context, projectSlug string, options InsightsGetProjectSummaryOptions) (*ProjectSummary, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	u := fmt.Sprintf("insights/pages/%s/summary", projectSlug)
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	ps := &ProjectSummary{}
+	err = s.client.do(ctx, req, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+type InsightsBranches struct {
+	OrgID     string   `json:"org_id"`
+	ProjectID string   `json:"project_id"`
+	Branches  []string `json:"branches"`
+}
+
+type InsightsListBranchesOptions struct {
+	WorkflowName *string `url:"workflow-name,omitempty"`
+}
+
+func (o InsightsListBranchesOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+func (s *insights) ListBranches(ctx context.Context, projectSlug string, options InsightsListBranchesOptions) (*InsightsBranches, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	u := fmt.Sprintf("insights/%s/branches", projectSlug)
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	ib := &InsightsBranches{}
+	err = s.client.do(ctx, req, ib)
+	if err != nil {
+		return nil, err
+	}
+
+	return ib, nil
+}
+
+type FlakyTests struct {
+	FlakyTests      []*FlakyTest `json:"flaky_tests"`
+	TotalFlakyTests int          `json:"total_flaky_tests"`
+}
+
+type FlakyTest struct {
+	TestName          string    `json:"test_name"`
+	Classname         string    `json:"classname"`
+	File              string    `json:"file"`
+	Source            string    `json:"source"`
+	JobName           string    `json:"job_name"`
+	JobNumber         int64     `json:"job_number"`
+	WorkflowName      string    `json:"workflow_name"`
+	WorkflowID        string    `json:"workflow_id"`
+	WorkflowCreatedAt time.Time `json:"workflow_created_at"`
+	PipelineNumber    int64     `json:"pipeline_number"`
+	TimesFlaked       int       `json:"times_flaked"`
+	TimeWasted        int64     `json:"time_wasted"`
+}
+
+func (s *insights) GetFlakyTests(ctx context.Context, projectSlug string) (*FlakyTests, error) {
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	u := fmt.Sprintf("insights/%s/flaky-tests", projectSlug)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ft := &FlakyTests{}
+	err = s.client.do(ctx, req, ft)
+	if err != nil {
+		return nil, err
+	}
+
+	return ft, nil
+}
+
+type OrgSummary struct {
+	OrgData        *OrgSummaryData      `json:"org_data"`
+	OrgProjectData []*OrgProjectSummary `json:"org_project_data"`
+	AllProjects    []string             `json:"all_projects"`
+}
+
+type OrgSummaryData struct {
+	Metrics *SummaryAggregateMetrics `json:"metrics"`
+	Trends  *SummaryAggregateMetrics `json:"trends"`
+}
+
+type OrgProjectSummary struct {
+	ProjectName string                   `json:"project_name"`
+	Metrics     *SummaryAggregateMetrics `json:"metrics"`
+	Trends      *SummaryAggregateMetrics `json:"trends"`
+}
+
+type InsightsGetOrgSummaryOptions struct {
+	ReportingWindow *ReportingWindowType `url:"reporting-window,omitempty"`
+	ProjectNames    []string             `url:"project-names,omitempty"`
+}
+
+func (o InsightsGetOrgSummaryOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+func (s *insights) GetOrgSummary(ctx context.Context, orgSlug string, options InsightsGetOrgSummaryOptions) (*OrgSummary, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&orgSlug) {
+		return nil, ErrRequiredOrganizationSlug
+	}
+
+	u := fmt.Sprintf("insights/%s/summary", orgSlug)
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := &OrgSummary{}
+	err = s.client.do(ctx, req, orgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+type JobTimeSeriesList struct {
+	Items         []*JobTimeSeries `json:"items"`
+	NextPageToken string           `json:"next_page_token"`
+}
+
+type JobTimeSeries struct {
+	Name         string             `json:"name"`
+	MinStartedAt time.Time          `json:"min_started_at"`
+	MaxEndedAt   time.Time          `json:"max_ended_at"`
+	Timestamp    time.Time          `json:"timestamp"`
+	Metrics      *TimeSeriesMetrics `json:"metrics"`
+}
+
+type TimeSeriesMetrics struct {
+	TotalRuns         int                        `json:"total_runs"`
+	FailedRuns        int                        `json:"failed_runs"`
+	SuccessfulRuns    int                        `json:"successful_runs"`
+	Throughput        float64                    `json:"throughput"`
+	MedianCreditsUsed float64                    `json:"median_credits_used"`
+	TotalCreditsUsed  float64                    `json:"total_credits_used"`
+	DurationMetrics   *TimeSeriesDurationMetrics `json:"duration_metrics"`
+}
+
+type TimeSeriesDurationMetrics struct {
+	Min    int `json:"min"`
+	Median int `json:"median"`
+	Max    int `json:"max"`
+	P95    int `json:"p95"`
+	Total  int `json:"total"`
+}
+
+type InsightsListJobTimeSeriesOptions struct {
+	Branch      *string          `url:"branch,omitempty"`
+	Granularity *GranularityType `url:"granularity,omitempty"`
+	StartDate   *time.Time       `url:"start-date,omitempty"`
+	EndDate     *time.Time       `url:"end-date,omitempty"`
+	PageToken   *string          `url:"page-token,omitempty"`
+}
+
+func (o InsightsListJobTimeSeriesOptions) valid() error {
+	if err := validInsightsRange(o.StartDate, o.EndDate); err != nil {
+		return err
+	}
+
+	if !validGranularity(o.Granularity) {
+		return ErrInvalidInsightsGranularity
+	}
+
+	if o.StartDate != nil && o.StartDate.Before(timeNow().Add(-insightsRetention)) {
+		return ErrInvalidInsightsStartDate
+	}
+
+	return nil
+}
+
+// chunks splits the requested range into ranges short enough to be served
+// by a single request. It returns nil when no splitting is needed.
+func (o InsightsListJobTimeSeriesOptions) chunks() []InsightsListJobTimeSeriesOptions {
+	if o.StartDate == nil || o.PageToken != nil {
+		return nil
+	}
+
+	granularity := GranularityDaily
+	if o.Granularity != nil {
+		granularity = *o.Granularity
+	}
+	max := maxInsightsTimeSeriesRange[granularity]
+
+	end := timeNow()
+	if o.EndDate != nil {
+		end = *o.EndDate
+	}
+
+	if end.Sub(*o.StartDate) <= max {
+		return nil
+	}
+
+	var chunks []InsightsListJobTimeSeriesOptions
+	for start := *o.StartDate; start.Before(end); start = start.Add(max) {
+		chunkEnd := start.Add(max)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+
+		chunk := o
+		chunk.StartDate = Time(start)
+		chunk.EndDate = Time(chunkEnd)
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+func (s *insights) ListJobTimeSeries(ctx context.Context, projectSlug, workflowName string, options InsightsListJobTimeSeriesOptions) (*JobTimeSeriesList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	if !validString(&workflowName) {
+		return nil, ErrRequiredWorkflowName
+	}
+
+	if chunks := options.chunks(); chunks != nil {
+		return s.listJobTimeSeriesChunks(ctx, projectSlug, workflowName, chunks)
+	}
+
+	u := fmt.Sprintf("insights/time-series/%s/workflows/%s/jobs", projectSlug, workflowName)
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	jtsl := &JobTimeSeriesList{}
+	err = s.client.do(ctx, req, jtsl)
+	if err != nil {
+		return nil, err
+	}
+
+	return jtsl, nil
+}
+
+// listJobTimeSeriesChunks fetches every page of each chunk and merges the
+// results into a single list. Adjacent chunks share their boundary, so the
+// bucket at a boundary may be returned twice and is only kept once.
+func (s *insights) listJobTimeSeriesChunks(ctx context.Context, projectSlug, workflowName string, chunks []InsightsListJobTimeSeriesOptions) (*JobTimeSeriesList, error) {
+	type bucket struct {
+		name      string
+		timestamp time.Time
+	}
+	seen := make(map[bucket]bool)
+	merged := &JobTimeSeriesList{}
+
+	for _, chunk := range chunks {
+		for {
+			jtsl, err := s.ListJobTimeSeries(ctx, projectSlug, workflowName, chunk)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range jtsl.Items {
+				b := bucket{name: item.Name, timestamp: item.Timestamp.UTC()}
+				if seen[b] {
+					continue
+				}
+				seen[b] = true
+				merged.Items = append(merged.Items, item)
+			}
+
+			if jtsl.NextPageToken == "" {
+				break
+			}
+			chunk.PageToken = String(jtsl.NextPageToken)
+		}
+	}
+
+	return merged, nil
+}
+
+type InsightsListSummaryMetricsInRangeOptions struct {
+	Branch *string
+	// Granularity is only used to summarize jobs, whose summary is computed
+	// from their time-series.
+	Granularity *GranularityType
+	StartDate   *time.Time
+	EndDate     *time.Time
+}
+
+func (o InsightsListSummaryMetricsInRangeOptions) valid() error {
+	if o.StartDate == nil {
+		return ErrRequiredInsightsStartDate
+	}
+
+	if err := validInsightsRange(o.StartDate, o.EndDate); err != nil {
+		return err
+	}
+
+	if o.StartDate.Before(timeNow().Add(-insightsRetention)) {
+		return ErrInvalidInsightsStartDate
+	}
+
+	return nil
+}
+
+// end returns the end of the range, which defaults to now.
+func (o InsightsListSummaryMetricsInRangeOptions) end() time.Time {
+	if o.EndDate != nil {
+		return *o.EndDate
+	}
+	return timeNow()
+}
+
+// runChunks splits the range into ranges short enough to be served by a
+// single request to the runs endpoints.
+func (o InsightsListSummaryMetricsInRangeOptions) runChunks() []InsightsListWorkflowRunsOptions {
+	end := o.end()
+
+	var chunks []InsightsListWorkflowRunsOptions
+	for start := *o.StartDate; start.Before(end); start = start.Add(maxInsightsRunsRange) {
+		chunkEnd := start.Add(maxInsightsRunsRange)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+
+		chunks = append(chunks, InsightsListWorkflowRunsOptions{
+			Branch:    o.Branch,
+			StartDate: Time(start),
+			EndDate:   Time(chunkEnd),
+		})
+	}
+
+	return chunks
+}
+
+// RangeSummaryMetricsList is a summary over an explicit range, computed from
+// other endpoints as the summary endpoints only accept fixed reporting
+// windows. Unlike SummaryMetricsList, its metrics keep the fractional values
+// the API reports.
+type RangeSummaryMetricsList struct {
+	Items []*RangeSummaryMetrics `json:"items"`
+}
+
+type RangeSummaryMetrics struct {
+	Name        string        `json:"name"`
+	WindowStart time.Time     `json:"window_start"`
+	WindowEnd   time.Time     `json:"window_end"`
+	Metrics     *RangeMetrics `json:"metrics"`
+}
+
+type RangeMetrics struct {
+	TotalRuns      int `json:"total_runs"`
+	SuccessfulRuns int `json:"successful_runs"`
+	FailedRuns     int `json:"failed_runs"`
+	// SuccessRate is the ratio of successful runs, between 0 and 1.
+	SuccessRate float64 `json:"success_rate"`
+	// Throughput is the average number of runs per day.
+	Throughput       float64               `json:"throughput"`
+	TotalCreditsUsed float64               `json:"total_credits_used"`
+	DurationMetrics  *RangeDurationMetrics `json:"duration_metrics"`
+}
+
+// RangeDurationMetrics holds durations in seconds. Medians and percentiles
+// can't be derived from the data the summary is computed from.
+type RangeDurationMetrics struct {
+	Min  int     `json:"min"`
+	Mean float64 `json:"mean"`
+	Max  int     `json:"max"`
+}
+
+// ListSummaryMetricsForWorkflowsInRange summarizes the metrics of each
+// workflow of a project over an arbitrary range. The summary is computed
+// from the runs of each workflow the project summary lists.
+func (s *insights) ListSummaryMetricsForWorkflowsInRange(ctx context.Context, projectSlug string, options InsightsListSummaryMetricsInRangeOptions) (*RangeSummaryMetricsList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	ps, err := s.GetProjectSummary(ctx, projectSlug, InsightsGetProjectSummaryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := options.runChunks()
+	sum := newRangeSummarizer(*options.StartDate, options.end())
+
+	for _, name := range ps.AllWorkflows {
+		// Workflows without runs in the range are listed with zero metrics.
+		sum.add(name, 0, 0, 0, 0, 0, 0, 0)
+
+		// Adjacent chunks share their boundary, so a run at a boundary may
+		// be returned twice and is only counted once.
+		seen := make(map[string]bool)
+		for _, chunk := range chunks {
+			for {
+				wrl, err := s.ListWorkflowRuns(ctx, projectSlug, name, chunk)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, run := range wrl.Items {
+					if seen[run.ID] {
+						continue
+					}
+					seen[run.ID] = true
+
+					successful, failed := 0, 0
+					switch run.Status {
+					case "success":
+						successful = 1
+					case "failed", "error":
+						failed = 1
+					}
+					sum.add(name, 1, successful, failed, float64(run.CreditsUsed), run.Duration, run.Duration, run.Duration)
+				}
+
+				if wrl.NextPageToken == "" {
+					break
+				}
+				chunk.PageToken = String(wrl.NextPageToken)
+			}
+		}
+	}
+
+	return sum.list(), nil
+}
+
+// ListSummaryMetricsForWorkflowJobsInRange summarizes the metrics of each job
+// of a workflow over an arbitrary range. The summary is computed from the
+// job time-series.
+func (s *insights) ListSummaryMetricsForWorkflowJobsInRange(ctx context.Context, projectSlug, workflowName string, options InsightsListSummaryMetricsInRangeOptions) (*RangeSummaryMetricsList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	jtsl, err := s.ListJobTimeSeries(ctx, projectSlug, workflowName, InsightsListJobTimeSeriesOptions{
+		Branch:      options.Branch,
+		Granularity: options.Granularity,
+		StartDate:   options.StartDate,
+		EndDate:     options.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sum := newRangeSummarizer(*options.StartDate, options.end())
+	for _, item := range jtsl.Items {
+		m := item.Metrics
+		if m == nil {
+			sum.add(item.Name, 0, 0, 0, 0, 0, 0, 0)
+			continue
+		}
+		var shortest, longest, total int
+		if d := m.DurationMetrics; d != nil {
+			shortest, longest, total = d.Min, d.Max, d.Total
+		}
+		sum.add(item.Name, m.TotalRuns, m.SuccessfulRuns, m.FailedRuns, m.TotalCreditsUsed, shortest, longest, total)
+	}
+
+	return sum.list(), nil
+}
+
+// rangeSummarizer accumulates metrics by name into a RangeSummaryMetricsList.
+type rangeSummarizer struct {
+	start, end time.Time
+	byName     map[string]*RangeSummaryMetrics
+	totals     map[string]float64
+	names      []string
+}
+
+func newRangeSummarizer(start, end time.Time) *rangeSummarizer {
+	return &rangeSummarizer{
+		start:  start,
+		end:    end,
+		byName: make(map[string]*RangeSummaryMetrics),
+		totals: make(map[string]float64),
+	}
+}
+
+// add accounts for runs of name taking total seconds altogether, the
+// shortest of which took shortest seconds and the longest longest.
+func (s *rangeSummarizer) add(name string, runs, successful, failed int, credits float64, shortest, longest, total int) {
+	sm, ok := s.byName[name]
+	if !ok {
+		sm = &RangeSummaryMetrics{
+			Name:        name,
+			WindowStart: s.start,
+			WindowEnd:   s.end,
+			Metrics:     &RangeMetrics{DurationMetrics: &RangeDurationMetrics{}},
+		}
+		s.byName[name] = sm
+		s.names = append(s.names, name)
+	}
+
+	if runs == 0 {
+		return
+	}
+
+	m := sm.Metrics
+	if m.TotalRuns == 0 || shortest < m.DurationMetrics.Min {
+		m.DurationMetrics.Min = shortest
+	}
+	if longest > m.DurationMetrics.Max {
+		m.DurationMetrics.Max = longest
+	}
+	m.TotalRuns += runs
+	m.SuccessfulRuns += successful
+	m.FailedRuns += failed
+	m.TotalCreditsUsed += credits
+	s.totals[name] += float64(total)
+}
+
+func (s *rangeSummarizer) list() *RangeSummaryMetricsList {
+	days := s.end.Sub(s.start).Hours() / 24
+
+	sort.Strings(s.names)
+	l := &RangeSummaryMetricsList{}
+	for _, name := range s.names {
+		sm := s.byName[name]
+		m := sm.Metrics
+		if m.TotalRuns > 0 {
+			m.DurationMetrics.Mean = s.totals[name] / float64(m.TotalRuns)
+			m.SuccessRate = float64(m.SuccessfulRuns) / float64(m.TotalRuns)
+		}
+		if days > 0 {
+			m.Throughput = float64(m.TotalRuns) / days
+		}
+		l.Items = append(l.Items, sm)
+	}
+
+	return l
+}
@@ -220,3 +220,503 @@ func Test_insights_ListWorkflowJobRuns(t *testing.T) {
 		t.Errorf("Insights.ListWorkflowJobRuns got %+v, want %+v", wrl, want)
 	}
 }
+
+func Test_insights_GetProjectSummary(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/insights/pages/%s/summary", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "reporting-window", "last-7-days")
+		if got, want := r.URL.Query()["branches"], []string{"main", "dev"}; !cmp.Equal(got, want) {
+			t.Errorf("URL.Query(%q) got %q, want %q", "branches", got, want)
+		}
+		fmt.Fprint(w, `{"project_id": "1", "project_data": {"metrics": {"total_runs": 10, "success_rate": 0.9}}, "project_workflow_data": [{"workflow_name": "workflow1"}], "all_branches": ["main", "dev"]}`)
+	})
+
+	ctx := context.Background()
+	ps, err := client.Insights.GetProjectSummary(ctx, projectSlug, InsightsGetProjectSummaryOptions{
+		ReportingWindow: ReportingWindow(Last7Days),
+		Branches:        []string{"main", "dev"},
+	})
+	if err != nil {
+		t.Errorf("Insights.GetProjectSummary got error: %v", err)
+	}
+
+	want := &ProjectSummary{
+		ProjectID: "1",
+		ProjectData: &ProjectSummaryData{
+			Metrics: &SummaryAggregateMetrics{
+				TotalRuns:   10,
+				SuccessRate: 0.9,
+			},
+		},
+		ProjectWorkflowData: []*ProjectWorkflowSummary{
+			{WorkflowName: "workflow1"},
+		},
+		AllBranches: []string{"main", "dev"},
+	}
+
+	if !cmp.Equal(ps, want) {
+		t.Errorf("Insights.GetProjectSummary got %+v, want %+v", ps, want)
+	}
+}
+
+func Test_insights_ListBranches(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/insights/%s/branches", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "workflow-name", "workflow1")
+		fmt.Fprint(w, `{"project_id": "1", "branches": ["main"]}`)
+	})
+
+	ctx := context.Background()
+	ib, err := client.Insights.ListBranches(ctx, projectSlug, InsightsListBranchesOptions{
+		WorkflowName: String("workflow1"),
+	})
+	if err != nil {
+		t.Errorf("Insights.ListBranches got error: %v", err)
+	}
+
+	want := &InsightsBranches{
+		ProjectID: "1",
+		Branches:  []string{"main"},
+	}
+
+	if !cmp.Equal(ib, want) {
+		t.Errorf("Insights.ListBranches got %+v, want %+v", ib, want)
+	}
+}
+
+func Test_insights_GetFlakyTests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/insights/%s/flaky-tests", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"flaky_tests": [{"test_name": "test1", "times_flaked": 3}], "total_flaky_tests": 1}`)
+	})
+
+	ctx := context.Background()
+	ft, err := client.Insights.GetFlakyTests(ctx, projectSlug)
+	if err != nil {
+		t.Errorf("Insights.GetFlakyTests got error: %v", err)
+	}
+
+	want := &FlakyTests{
+		FlakyTests: []*FlakyTest{
+			{
+				TestName:    "test1",
+				TimesFlaked: 3,
+			},
+		},
+		TotalFlakyTests: 1,
+	}
+
+	if !cmp.Equal(ft, want) {
+		t.Errorf("Insights.GetFlakyTests got %+v, want %+v", ft, want)
+	}
+}
+
+func Test_insights_GetOrgSummary(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgSlug := "gh/org1"
+
+	mux.HandleFunc(fmt.Sprintf("/insights/%s/summary", orgSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "reporting-window", "last-30-days")
+		testQuery(t, r, "project-names", "prj1")
+		fmt.Fprint(w, `{"org_data": {"metrics": {"total_credits_used": 100}}, "org_project_data": [{"project_name": "prj1"}], "all_projects": ["prj1"]}`)
+	})
+
+	ctx := context.Background()
+	orgs, err := client.Insights.GetOrgSummary(ctx, orgSlug, InsightsGetOrgSummaryOptions{
+		ReportingWindow: ReportingWindow(Last30Days),
+		ProjectNames:    []string{"prj1"},
+	})
+	if err != nil {
+		t.Errorf("Insights.GetOrgSummary got error: %v", err)
+	}
+
+	want := &OrgSummary{
+		OrgData: &OrgSummaryData{
+			Metrics: &SummaryAggregateMetrics{
+				TotalCreditsUsed: 100,
+			},
+		},
+		OrgProjectData: []*OrgProjectSummary{
+			{ProjectName: "prj1"},
+		},
+		AllProjects: []string{"prj1"},
+	}
+
+	if !cmp.Equal(orgs, want) {
+		t.Errorf("Insights.GetOrgSummary got %+v, want %+v", orgs, want)
+	}
+}
+
+func Test_insights_ListJobTimeSeries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+	workflowName := "workflow1"
+
+	mux.HandleFunc(fmt.Sprintf("/insights/time-series/%s/workflows/%s/jobs", projectSlug, workflowName), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "granularity", "daily")
+		testQuery(t, r, "branch", "main")
+		fmt.Fprint(w, `{"items": [{"name": "job1", "metrics": {"total_runs": 2, "duration_metrics": {"max": 10}}}], "next_page_token": "1"}`)
+	})
+
+	ctx := context.Background()
+	jtsl, err := client.Insights.ListJobTimeSeries(ctx, projectSlug, workflowName, InsightsListJobTimeSeriesOptions{
+		Branch:      String("main"),
+		Granularity: Granularity(GranularityDaily),
+	})
+	if err != nil {
+		t.Errorf("Insights.ListJobTimeSeries got error: %v", err)
+	}
+
+	want := &JobTimeSeriesList{
+		Items: []*JobTimeSeries{
+			{
+				Name: "job1",
+				Metrics: &TimeSeriesMetrics{
+					TotalRuns: 2,
+					DurationMetrics: &TimeSeriesDurationMetrics{
+						Max: 10,
+					},
+				},
+			},
+		},
+		NextPageToken: "1",
+	}
+
+	if !cmp.Equal(jtsl, want) {
+		t.Errorf("Insights.ListJobTimeSeries got %+v, want %+v", jtsl, want)
+	}
+}
+
+func Test_insights_ListJobTimeSeries_chunked(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	now := time.Date(2022, 3, 10, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	projectSlug := "gh/org1/prj1"
+	workflowName := "workflow1"
+	start := now.Add(-100 * time.Hour)
+
+	var got []string
+	mux.HandleFunc(fmt.Sprintf("/insights/time-series/%s/workflows/%s/jobs", projectSlug, workflowName), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "granularity", "hourly")
+		q := r.URL.Query()
+		got = append(got, q.Get("start-date")+"/"+q.Get("end-date")+"/"+q.Get("page-token"))
+		if q.Get("page-token") == "" && q.Get("start-date") == start.Format(time.RFC3339) {
+			fmt.Fprint(w, `{"items": [{"name": "job1"}], "next_page_token": "2"}`)
+			return
+		}
+		// Each chunk returns the buckets at both of its ends.
+		fmt.Fprintf(w, `{"items": [{"name": "job2", "timestamp": "%s"}, {"name": "job2", "timestamp": "%s"}]}`, q.Get("start-date"), q.Get("end-date"))
+	})
+
+	ctx := context.Background()
+	jtsl, err := client.Insights.ListJobTimeSeries(ctx, projectSlug, workflowName, InsightsListJobTimeSeriesOptions{
+		Granularity: Granularity(GranularityHourly),
+		StartDate:   Time(start),
+	})
+	if err != nil {
+		t.Errorf("Insights.ListJobTimeSeries got error: %v", err)
+	}
+
+	wantRequests := []string{
+		"2022-03-05T20:00:00Z/2022-03-07T20:00:00Z/",
+		"2022-03-05T20:00:00Z/2022-03-07T20:00:00Z/2",
+		"2022-03-07T20:00:00Z/2022-03-09T20:00:00Z/",
+		"2022-03-09T20:00:00Z/2022-03-10T00:00:00Z/",
+	}
+	if !cmp.Equal(got, wantRequests) {
+		t.Errorf("Insights.ListJobTimeSeries requested %v, want %v", got, wantRequests)
+	}
+
+	want := &JobTimeSeriesList{
+		Items: []*JobTimeSeries{
+			{Name: "job1"},
+			{Name: "job2", Timestamp: start},
+			{Name: "job2", Timestamp: start.Add(48 * time.Hour)},
+			{Name: "job2", Timestamp: start.Add(96 * time.Hour)},
+			{Name: "job2", Timestamp: now},
+		},
+	}
+
+	if !cmp.Equal(jtsl, want) {
+		t.Errorf("Insights.ListJobTimeSeries got %+v, want %+v", jtsl, want)
+	}
+}
+
+func Test_InsightsListJobTimeSeriesOptions_valid(t *testing.T) {
+	now := time.Date(2022, 3, 10, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	tests := []struct {
+		name    string
+		options InsightsListJobTimeSeriesOptions
+		want    error
+	}{
+		{
+			name:    "no range",
+			options: InsightsListJobTimeSeriesOptions{},
+		},
+		{
+			name: "end without start",
+			options: InsightsListJobTimeSeriesOptions{
+				EndDate: Time(now),
+			},
+			want: ErrRequiredInsightsStartDate,
+		},
+		{
+			name: "end before start",
+			options: InsightsListJobTimeSeriesOptions{
+				StartDate: Time(now),
+				EndDate:   Time(now.Add(-time.Hour)),
+			},
+			want: ErrInvalidInsightsDateRange,
+		},
+		{
+			name: "start beyond retention",
+			options: InsightsListJobTimeSeriesOptions{
+				StartDate: Time(now.AddDate(0, 0, -91)),
+			},
+			want: ErrInvalidInsightsStartDate,
+		},
+		{
+			name: "unknown granularity",
+			options: InsightsListJobTimeSeriesOptions{
+				Granularity: Granularity("weekly"),
+			},
+			want: ErrInvalidInsightsGranularity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.options.valid(); got != tt.want {
+				t.Errorf("valid() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_insights_ListSummaryMetricsForWorkflowJobsInRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	now := time.Date(2022, 3, 10, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	projectSlug := "gh/org1/prj1"
+	workflowName := "workflow1"
+	start := now.AddDate(0, 0, -4)
+
+	mux.HandleFunc(fmt.Sprintf("/insights/time-series/%s/workflows/%s/jobs", projectSlug, workflowName), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"items": [
+			{"name": "job1", "metrics": {"total_runs": 2, "successful_runs": 1, "failed_runs": 1, "total_credits_used": 10.5, "duration_metrics": {"min": 5, "max": 15, "total": 20}}},
+			{"name": "job1", "metrics": {"total_runs": 1, "successful_runs": 1, "total_credits_used": 6.25, "duration_metrics": {"min": 3, "max": 3, "total": 3}}}
+		]}`)
+	})
+
+	ctx := context.Background()
+	sml, err := client.Insights.ListSummaryMetricsForWorkflowJobsInRange(ctx, projectSlug, workflowName, InsightsListSummaryMetricsInRangeOptions{
+		StartDate: Time(start),
+	})
+	if err != nil {
+		t.Errorf("Insights.ListSummaryMetricsForWorkflowJobsInRange got error: %v", err)
+	}
+
+	want := &RangeSummaryMetricsList{
+		Items: []*RangeSummaryMetrics{
+			{
+				Name:        "job1",
+				WindowStart: start,
+				WindowEnd:   now,
+				Metrics: &RangeMetrics{
+					TotalRuns:        3,
+					SuccessfulRuns:   2,
+					FailedRuns:       1,
+					TotalCreditsUsed: 16.75,
+					SuccessRate:      2.0 / 3,
+					Throughput:       0.75,
+					DurationMetrics: &RangeDurationMetrics{
+						Min:  3,
+						Mean: 23.0 / 3,
+						Max:  15,
+					},
+				},
+			},
+		},
+	}
+
+	if !cmp.Equal(sml, want) {
+		t.Errorf("Insights.ListSummaryMetricsForWorkflowJobsInRange got %+v, want %+v", sml, want)
+	}
+}
+
+func Test_insights_ListSummaryMetricsForWorkflowsInRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	now := time.Date(2022, 3, 10, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	projectSlug := "gh/org1/prj1"
+	start := now.AddDate(0, 0, -10)
+
+	mux.HandleFunc(fmt.Sprintf("/insights/pages/%s/summary", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"all_workflows": ["deploy", "build"]}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/insights/%s/workflows/build", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "branch", "main")
+		testQuery(t, r, "start-date", start.Format(time.RFC3339))
+		testQuery(t, r, "end-date", now.Format(time.RFC3339))
+		if r.URL.Query().Get("page-token") == "" {
+			fmt.Fprint(w, `{"items": [{"id": "run1", "status": "success", "duration": 60, "credits_used": 10}], "next_page_token": "2"}`)
+			return
+		}
+		fmt.Fprint(w, `{"items": [{"id": "run2", "status": "failed", "duration": 30, "credits_used": 5}, {"id": "run3", "status": "canceled", "duration": 10, "credits_used": 1}]}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/insights/%s/workflows/deploy", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": []}`)
+	})
+
+	ctx := context.Background()
+	sml, err := client.Insights.ListSummaryMetricsForWorkflowsInRange(ctx, projectSlug, InsightsListSummaryMetricsInRangeOptions{
+		Branch:    String("main"),
+		StartDate: Time(start),
+		EndDate:   Time(now),
+	})
+	if err != nil {
+		t.Errorf("Insights.ListSummaryMetricsForWorkflowsInRange got error: %v", err)
+	}
+
+	want := &RangeSummaryMetricsList{
+		Items: []*RangeSummaryMetrics{
+			{
+				Name:        "build",
+				WindowStart: start,
+				WindowEnd:   now,
+				Metrics: &RangeMetrics{
+					TotalRuns:        3,
+					SuccessfulRuns:   1,
+					FailedRuns:       1,
+					TotalCreditsUsed: 16,
+					SuccessRate:      1.0 / 3,
+					Throughput:       0.3,
+					DurationMetrics:  &RangeDurationMetrics{Min: 10, Mean: 100.0 / 3, Max: 60},
+				},
+			},
+			{
+				Name:        "deploy",
+				WindowStart: start,
+				WindowEnd:   now,
+				Metrics:     &RangeMetrics{DurationMetrics: &RangeDurationMetrics{}},
+			},
+		},
+	}
+
+	if !cmp.Equal(sml, want) {
+		t.Errorf("Insights.ListSummaryMetricsForWorkflowsInRange got diff (-got +want):\n%s", cmp.Diff(sml, want))
+	}
+}
+
+func Test_insights_ListSummaryMetricsForWorkflowsInRange_chunks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	now := time.Date(2022, 3, 10, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	maxInsightsRunsRange = 4 * 24 * time.Hour
+	defer func() { maxInsightsRunsRange = 90 * 24 * time.Hour }()
+
+	projectSlug := "gh/org1/prj1"
+	start := now.AddDate(0, 0, -10)
+
+	mux.HandleFunc(fmt.Sprintf("/insights/pages/%s/summary", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"all_workflows": ["build"]}`)
+	})
+
+	var ranges []string
+	mux.HandleFunc(fmt.Sprintf("/insights/%s/workflows/build", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.URL.Query()
+		ranges = append(ranges, q.Get("start-date")+" "+q.Get("end-date"))
+		// run1 is at the boundary of the first two chunks.
+		switch q.Get("start-date") {
+		case start.Format(time.RFC3339):
+			fmt.Fprint(w, `{"items": [{"id": "run1", "status": "success", "duration": 10}]}`)
+		case start.AddDate(0, 0, 4).Format(time.RFC3339):
+			fmt.Fprint(w, `{"items": [{"id": "run1", "status": "success", "duration": 10}, {"id": "run2", "status": "failed", "duration": 20}]}`)
+		default:
+			fmt.Fprint(w, `{"items": [{"id": "run3", "status": "success", "duration": 30}]}`)
+		}
+	})
+
+	ctx := context.Background()
+	sml, err := client.Insights.ListSummaryMetricsForWorkflowsInRange(ctx, projectSlug, InsightsListSummaryMetricsInRangeOptions{
+		StartDate: Time(start),
+	})
+	if err != nil {
+		t.Fatalf("Insights.ListSummaryMetricsForWorkflowsInRange got error: %v", err)
+	}
+
+	wantRanges := []string{
+		start.Format(time.RFC3339) + " " + start.AddDate(0, 0, 4).Format(time.RFC3339),
+		start.AddDate(0, 0, 4).Format(time.RFC3339) + " " + start.AddDate(0, 0, 8).Format(time.RFC3339),
+		start.AddDate(0, 0, 8).Format(time.RFC3339) + " " + now.Format(time.RFC3339),
+	}
+	if !cmp.Equal(ranges, wantRanges) {
+		t.Errorf("Insights.ListSummaryMetricsForWorkflowsInRange requested diff (-got +want):\n%s", cmp.Diff(ranges, wantRanges))
+	}
+
+	if len(sml.Items) != 1 || sml.Items[0].Metrics.TotalRuns != 3 || sml.Items[0].Metrics.SuccessfulRuns != 2 {
+		t.Errorf("Insights.ListSummaryMetricsForWorkflowsInRange got %+v, want 3 runs of which 2 successful", sml.Items[0].Metrics)
+	}
+
+	_, err = client.Insights.ListSummaryMetricsForWorkflowsInRange(ctx, projectSlug, InsightsListSummaryMetricsInRangeOptions{
+		StartDate: Time(now.AddDate(0, 0, -91)),
+	})
+	if err != ErrInvalidInsightsStartDate {
+		t.Errorf("Insights.ListSummaryMetricsForWorkflowsInRange got error %v, want %v", err, ErrInvalidInsightsStartDate)
+	}
+}
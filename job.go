@@ -3,15 +3,24 @@ package circleci
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"regexp"
 	"time"
 )
 
 type Jobs interface {
 	Get(ctx context.Context, projectSlug string, jobNumber string) (*Job, error)
 	Cancel(ctx context.Context, projectSlug string, jobNumber string) error
+	CancelByID(ctx context.Context, jobID string) error
+	FindInWorkflow(ctx context.Context, workflowID, jobID string) (*WorkflowJob, error)
+	Rerun(ctx context.Context, projectSlug string, jobNumber string, options JobRerunOptions) error
+	RerunByID(ctx context.Context, workflowID, jobID string, options JobRerunOptions) error
 	ListArtifacts(ctx context.Context, projectSlug string, jobNumber string) (*ArtifactList, error)
 	ListTestMetadata(ctx context.Context, projectSlug string, jobNumber string) (*TestMetadataList, error)
+	ListSteps(ctx context.Context, projectSlug string, jobNumber string) ([]*JobStep, error)
+	StreamStepOutput(ctx context.Context, action *JobStepAction, w io.Writer, options JobStreamStepOutputOptions) error
 }
 
 type jobs struct {
@@ -120,6 +129,114 @@ func (s *jobs) Cancel(ctx context.Context, projectSlug string, jobNumber string)
 	return s.client.do(ctx, req, nil)
 }
 
+func (s *jobs) CancelByID(ctx context.Context, jobID string) error {
+	if !validString(&jobID) {
+		return ErrRequiredJobID
+	}
+
+	u := fmt.Sprintf("jobs/%s/cancel", jobID)
+	req, err := s.client.newRequest("POST", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// FindInWorkflow searches the jobs of the given workflow for the one with the
+// given ID. It is not a lookup by ID: the API has no endpoint that returns a
+// job from its ID alone, so the ID of its workflow, which webhook payloads
+// carry along with the job's, is required. The returned job's ProjectSlug and
+// JobNumber can be passed to Get for its full details.
+func (s *jobs) FindInWorkflow(ctx context.Context, workflowID, jobID string) (*WorkflowJob, error) {
+	if !validString(&workflowID) {
+		return nil, ErrRequiredWorkflowID
+	}
+
+	if !validString(&jobID) {
+		return nil, ErrRequiredJobID
+	}
+
+	jl, err := s.client.Workflows.ListWorkflowJobs(ctx, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, j := range jl.Items {
+		if j.ID == jobID {
+			return j, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+type JobRerunOptions struct {
+	EnableSSH *bool
+}
+
+func (o JobRerunOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+// Rerun reruns a single job by rerunning it within the latest workflow it
+// ran in.
+func (s *jobs) Rerun(ctx context.Context, projectSlug string, jobNumber string, options JobRerunOptions) error {
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	j, err := s.Get(ctx, projectSlug, jobNumber)
+	if err != nil {
+		return err
+	}
+
+	if j.LatestWorkflow == nil || !validString(&j.LatestWorkflow.ID) {
+		return ErrRequiredWorkflowID
+	}
+
+	jl, err := s.client.Workflows.ListWorkflowJobs(ctx, j.LatestWorkflow.ID)
+	if err != nil {
+		return err
+	}
+
+	var jobID string
+	for _, wj := range jl.Items {
+		if wj.JobNumber == int64(j.Number) {
+			jobID = wj.ID
+			break
+		}
+	}
+
+	if jobID == "" {
+		return ErrNotFound
+	}
+
+	return s.RerunByID(ctx, j.LatestWorkflow.ID, jobID, options)
+}
+
+// RerunByID reruns a single job within its workflow, given the IDs a webhook
+// payload carries.
+func (s *jobs) RerunByID(ctx context.Context, workflowID, jobID string, options JobRerunOptions) error {
+	if !validString(&workflowID) {
+		return ErrRequiredWorkflowID
+	}
+
+	if !validString(&jobID) {
+		return ErrRequiredJobID
+	}
+
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	return s.client.Workflows.Rerun(ctx, workflowID, WorkflowRerunOptions{
+		Jobs:      []*string{String(jobID)},
+		EnableSSH: options.EnableSSH,
+	})
+}
+
 type ArtifactList struct {
 	Items         []*Artifact `json:"items"`
 	NextPageToken string      `json:"next_page_token"`
@@ -193,3 +310,143 @@ func (s *jobs) ListTestMetadata(ctx context.Context, projectSlug string, jobNumb
 
 	return tml, nil
 }
+
+type JobStep struct {
+	Name string `json:"name"`
+	// Actions holds one action per parallel run of the step.
+	Actions []*JobStepAction `json:"actions"`
+}
+
+type JobStepAction struct {
+	Name          string    `json:"name"`
+	Type          string    `json:"type"`
+	Step          int       `json:"step"`
+	Index         int       `json:"index"`
+	Status        string    `json:"status"`
+	Failed        bool      `json:"failed"`
+	ExitCode      *int      `json:"exit_code"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+	RunTimeMillis int64     `json:"run_time_millis"`
+	Parallel      bool      `json:"parallel"`
+	Background    bool      `json:"background"`
+	BashCommand   string    `json:"bash_command"`
+	HasOutput     bool      `json:"has_output"`
+	OutputURL     string    `json:"output_url"`
+}
+
+type jobDetails struct {
+	Steps []*JobStep `json:"steps"`
+}
+
+// ListSteps returns the steps of a job. Steps are only exposed by the v1.1
+// API.
+func (s *jobs) ListSteps(ctx context.Context, projectSlug string, jobNumber string) ([]*JobStep, error) {
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	if !validString(&jobNumber) {
+		return nil, ErrRequiredJobNumber
+	}
+
+	u := v1Path(fmt.Sprintf("project/%s/%s", v1ProjectSlug(projectSlug), jobNumber))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	jd := &jobDetails{}
+	err = s.client.do(ctx, req, jd)
+	if err != nil {
+		return nil, err
+	}
+
+	return jd.Steps, nil
+}
+
+type JobStreamStepOutputOptions struct {
+	// StripANSI removes ANSI escape sequences such as colors from the
+	// output.
+	StripANSI *bool
+}
+
+func (o JobStreamStepOutputOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+type jobStepOutput struct {
+	Message string    `json:"message"`
+	Type    string    `json:"type"`
+	Time    time.Time `json:"time"`
+}
+
+// StreamStepOutput writes the output of a step action to w as it is read.
+func (s *jobs) StreamStepOutput(ctx context.Context, action *JobStepAction, w io.Writer, options JobStreamStepOutputOptions) error {
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	if action == nil || !action.HasOutput || !validString(&action.OutputURL) {
+		return nil
+	}
+
+	body, err := s.client.openExternal(ctx, action.OutputURL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	dec := json.NewDecoder(body)
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	strip := options.StripANSI != nil && *options.StripANSI
+	// An escape sequence may be split across messages, so the unfinished
+	// one at the end of a message is held back until the next.
+	var partial string
+	for dec.More() {
+		var o jobStepOutput
+		if err := dec.Decode(&o); err != nil {
+			return err
+		}
+
+		msg := o.Message
+		if strip {
+			msg, partial = splitPartialANSI(partial + msg)
+			msg = stripANSI(msg)
+		}
+
+		if _, err := io.WriteString(w, msg); err != nil {
+			return err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	// The stream ended before the sequence did, so it was no escape.
+	_, err = io.WriteString(w, partial)
+	return err
+}
+
+var ansiEscape = regexp.MustCompile(`\x1b(\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(\x07|\x1b\\)|[@-Z\\-_])`)
+
+func stripANSI(s string) string {
+	return ansiEscape.ReplaceAllString(s, "")
+}
+
+var partialANSIEscape = regexp.MustCompile(`\x1b(\[[0-?]*[ -/]*|\][^\x07\x1b]*\x1b?)?$`)
+
+// splitPartialANSI splits s before an escape sequence that is cut off at its
+// end.
+func splitPartialANSI(s string) (string, string) {
+	loc := partialANSIEscape.FindStringIndex(s)
+	if loc == nil {
+		return s, ""
+	}
+	return s[:loc[0]], s[loc[0]:]
+}
@@ -1,6 +1,8 @@
 package circleci
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"net/http"
@@ -59,6 +61,120 @@ func Test_jobs_Cancel(t *testing.T) {
 	}
 }
 
+func Test_jobs_CancelByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	jobID := "job1"
+
+	mux.HandleFunc(fmt.Sprintf("/jobs/%s/cancel", jobID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"message": "success"}`)
+	})
+
+	ctx := context.Background()
+	err := client.Jobs.CancelByID(ctx, jobID)
+	if err != nil {
+		t.Errorf("Jobs.CancelByID got error: %v", err)
+	}
+}
+
+func Test_jobs_FindInWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	workflowID := "workflow1"
+	jobID := "job2"
+
+	mux.HandleFunc(fmt.Sprintf("/workflow/%s/job", workflowID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"items": [{"id": "job1", "job_number": 1}, {"id": "job2", "job_number": 2, "project_slug": "gh/org1/prj1"}]}`)
+	})
+
+	ctx := context.Background()
+	j, err := client.Jobs.FindInWorkflow(ctx, workflowID, jobID)
+	if err != nil {
+		t.Errorf("Jobs.FindInWorkflow got error: %v", err)
+	}
+
+	want := &WorkflowJob{
+		ID:          jobID,
+		JobNumber:   2,
+		ProjectSlug: "gh/org1/prj1",
+	}
+
+	if !cmp.Equal(j, want) {
+		t.Errorf("Jobs.FindInWorkflow got %+v, want %+v", j, want)
+	}
+
+	_, err = client.Jobs.FindInWorkflow(ctx, workflowID, "job3")
+	if err != ErrNotFound {
+		t.Errorf("Jobs.FindInWorkflow got error %v, want %v", err, ErrNotFound)
+	}
+}
+
+func Test_jobs_Rerun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+	jobNumber := "2"
+	workflowID := "workflow1"
+
+	mux.HandleFunc(fmt.Sprintf("/project/%s/job/%s", projectSlug, jobNumber), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number": 2, "latest_workflow": {"id": "workflow1"}}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/workflow/%s/job", workflowID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"items": [{"id": "job1", "job_number": 1}, {"id": "job2", "job_number": 2}]}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/workflow/%s/rerun", workflowID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"jobs":["job2"],"enable_ssh":true}`+"\n")
+		fmt.Fprint(w, `{"workflow_id": "workflow2"}`)
+	})
+
+	ctx := context.Background()
+	err := client.Jobs.Rerun(ctx, projectSlug, jobNumber, JobRerunOptions{
+		EnableSSH: Bool(true),
+	})
+	if err != nil {
+		t.Errorf("Jobs.Rerun got error: %v", err)
+	}
+}
+
+func Test_jobs_RerunByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	workflowID := "workflow1"
+	jobID := "job2"
+
+	mux.HandleFunc(fmt.Sprintf("/workflow/%s/rerun", workflowID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"jobs":["job2"]}`+"\n")
+		fmt.Fprint(w, `{"workflow_id": "workflow2"}`)
+	})
+
+	ctx := context.Background()
+	if err := client.Jobs.RerunByID(ctx, workflowID, jobID, JobRerunOptions{}); err != nil {
+		t.Errorf("Jobs.RerunByID got error: %v", err)
+	}
+
+	if err := client.Jobs.RerunByID(ctx, workflowID, "", JobRerunOptions{}); err != ErrRequiredJobID {
+		t.Errorf("Jobs.RerunByID got error %v, want %v", err, ErrRequiredJobID)
+	}
+}
+
 func Test_jobs_ListArtifacts(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -126,3 +242,118 @@ func Test_jobs_ListTestMetadata(t *testing.T) {
 		t.Errorf("Jobs.ListTestMetadata got %+v, want %+v", tml, want)
 	}
 }
+
+func Test_jobs_ListSteps(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+	jobNumber := "1"
+
+	mux.HandleFunc(fmt.Sprintf("/v1.1/project/github/org1/prj1/%s", jobNumber), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"build_num": 1, "steps": [{"name": "test", "actions": [{"name": "test", "index": 1, "status": "failed", "failed": true, "exit_code": 1, "run_time_millis": 1500, "has_output": true, "output_url": "https://example.com/output"}]}]}`)
+	})
+
+	ctx := context.Background()
+	steps, err := client.Jobs.ListSteps(ctx, projectSlug, jobNumber)
+	if err != nil {
+		t.Errorf("Jobs.ListSteps got error: %v", err)
+	}
+
+	exitCode := 1
+	want := []*JobStep{
+		{
+			Name: "test",
+			Actions: []*JobStepAction{
+				{
+					Name:          "test",
+					Index:         1,
+					Status:        "failed",
+					Failed:        true,
+					ExitCode:      &exitCode,
+					RunTimeMillis: 1500,
+					HasOutput:     true,
+					OutputURL:     "https://example.com/output",
+				},
+			},
+		},
+	}
+
+	if !cmp.Equal(steps, want) {
+		t.Errorf("Jobs.ListSteps got %+v, want %+v", steps, want)
+	}
+}
+
+func Test_jobs_StreamStepOutput(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/output", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Circle-Token", "")
+		gw := gzip.NewWriter(w)
+		fmt.Fprint(gw, `[{"message": "\u001b[32mok\u001b[0m\r\n", "type": "out"}, {"message": "connection refused\n", "type": "err"}]`)
+		gw.Close()
+	})
+
+	action := &JobStepAction{
+		HasOutput: true,
+		OutputURL: serverURL + "/output",
+	}
+
+	tests := []struct {
+		name      string
+		stripANSI bool
+		want      string
+	}{
+		{name: "raw", want: "\x1b[32mok\x1b[0m\r\nconnection refused\n"},
+		{name: "strip ANSI", stripANSI: true, want: "ok\r\nconnection refused\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			ctx := context.Background()
+			err := client.Jobs.StreamStepOutput(ctx, action, &buf, JobStreamStepOutputOptions{
+				StripANSI: Bool(tt.stripANSI),
+			})
+			if err != nil {
+				t.Errorf("Jobs.StreamStepOutput got error: %v", err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Jobs.StreamStepOutput got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_jobs_StreamStepOutput_splitEscape(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/output", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"message": "\u001b[3"}, {"message": "2mok\u001b"}, {"message": "[0m\r\n\u001b]0;title"}, {"message": "\u0007done\u001b["}]`)
+	})
+
+	action := &JobStepAction{
+		HasOutput: true,
+		OutputURL: serverURL + "/output",
+	}
+
+	var buf bytes.Buffer
+	ctx := context.Background()
+	err := client.Jobs.StreamStepOutput(ctx, action, &buf, JobStreamStepOutputOptions{
+		StripANSI: Bool(true),
+	})
+	if err != nil {
+		t.Errorf("Jobs.StreamStepOutput got error: %v", err)
+	}
+
+	if got, want := buf.String(), "ok\r\ndone\x1b["; got != want {
+		t.Errorf("Jobs.StreamStepOutput got %q, want %q", got, want)
+	}
+}
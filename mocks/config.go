@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: config.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockConfigs is a mock of Configs interface.
+type MockConfigs struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigsMockRecorder
+}
+
+// MockConfigsMockRecorder is the mock recorder for MockConfigs.
+type MockConfigsMockRecorder struct {
+	mock *MockConfigs
+}
+
+// NewMockConfigs creates a new mock instance.
+func NewMockConfigs(ctrl *gomock.Controller) *MockConfigs {
+	mock := &MockConfigs{ctrl: ctrl}
+	mock.recorder = &MockConfigsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigs) EXPECT() *MockConfigsMockRecorder {
+	return m.recorder
+}
+
+// Compile mocks base method.
+func (m *MockConfigs) Compile(ctx context.Context, options circleci.ConfigCompileOptions) (*circleci.ConfigCompilation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compile", ctx, options)
+	ret0, _ := ret[0].(*circleci.ConfigCompilation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Compile indicates an expected call of Compile.
+func (mr *MockConfigsMockRecorder) Compile(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compile", reflect.TypeOf((*MockConfigs)(nil).Compile), ctx, options)
+}
+
+// Validate mocks base method.
+func (m *MockConfigs) Validate(ctx context.Context, options circleci.ConfigCompileOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockConfigsMockRecorder) Validate(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockConfigs)(nil).Validate), ctx, options)
+}
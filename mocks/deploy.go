@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deploy.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockDeploys is a mock of Deploys interface.
+type MockDeploys struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeploysMockRecorder
+}
+
+// MockDeploysMockRecorder is the mock recorder for MockDeploys.
+type MockDeploysMockRecorder struct {
+	mock *MockDeploys
+}
+
+// NewMockDeploys creates a new mock instance.
+func NewMockDeploys(ctrl *gomock.Controller) *MockDeploys {
+	mock := &MockDeploys{ctrl: ctrl}
+	mock.recorder = &MockDeploysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeploys) EXPECT() *MockDeploysMockRecorder {
+	return m.recorder
+}
+
+// CreateRelease mocks base method.
+func (m *MockDeploys) CreateRelease(ctx context.Context, options circleci.DeployReleaseCreateOptions) (*circleci.DeployRelease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRelease", ctx, options)
+	ret0, _ := ret[0].(*circleci.DeployRelease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRelease indicates an expected call of CreateRelease.
+func (mr *MockDeploysMockRecorder) CreateRelease(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRelease", reflect.TypeOf((*MockDeploys)(nil).CreateRelease), ctx, options)
+}
+
+// GetComponent mocks base method.
+func (m *MockDeploys) GetComponent(ctx context.Context, componentID string) (*circleci.DeployComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponent", ctx, componentID)
+	ret0, _ := ret[0].(*circleci.DeployComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponent indicates an expected call of GetComponent.
+func (mr *MockDeploysMockRecorder) GetComponent(ctx, componentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponent", reflect.TypeOf((*MockDeploys)(nil).GetComponent), ctx, componentID)
+}
+
+// GetEnvironment mocks base method.
+func (m *MockDeploys) GetEnvironment(ctx context.Context, environmentID string) (*circleci.DeployEnvironment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", ctx, environmentID)
+	ret0, _ := ret[0].(*circleci.DeployEnvironment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment.
+func (mr *MockDeploysMockRecorder) GetEnvironment(ctx, environmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockDeploys)(nil).GetEnvironment), ctx, environmentID)
+}
+
+// ListComponentVersions mocks base method.
+func (m *MockDeploys) ListComponentVersions(ctx context.Context, componentID string, options circleci.DeployComponentVersionListOptions) (*circleci.DeployComponentVersionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComponentVersions", ctx, componentID, options)
+	ret0, _ := ret[0].(*circleci.DeployComponentVersionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComponentVersions indicates an expected call of ListComponentVersions.
+func (mr *MockDeploysMockRecorder) ListComponentVersions(ctx, componentID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComponentVersions", reflect.TypeOf((*MockDeploys)(nil).ListComponentVersions), ctx, componentID, options)
+}
+
+// ListComponents mocks base method.
+func (m *MockDeploys) ListComponents(ctx context.Context, options circleci.DeployComponentListOptions) (*circleci.DeployComponentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComponents", ctx, options)
+	ret0, _ := ret[0].(*circleci.DeployComponentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComponents indicates an expected call of ListComponents.
+func (mr *MockDeploysMockRecorder) ListComponents(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComponents", reflect.TypeOf((*MockDeploys)(nil).ListComponents), ctx, options)
+}
+
+// ListEnvironments mocks base method.
+func (m *MockDeploys) ListEnvironments(ctx context.Context, options circleci.DeployEnvironmentListOptions) (*circleci.DeployEnvironmentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", ctx, options)
+	ret0, _ := ret[0].(*circleci.DeployEnvironmentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockDeploysMockRecorder) ListEnvironments(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockDeploys)(nil).ListEnvironments), ctx, options)
+}
+
+// UpdateRelease mocks base method.
+func (m *MockDeploys) UpdateRelease(ctx context.Context, releaseID string, options circleci.DeployReleaseUpdateOptions) (*circleci.DeployRelease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRelease", ctx, releaseID, options)
+	ret0, _ := ret[0].(*circleci.DeployRelease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRelease indicates an expected call of UpdateRelease.
+func (mr *MockDeploysMockRecorder) UpdateRelease(ctx, releaseID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRelease", reflect.TypeOf((*MockDeploys)(nil).UpdateRelease), ctx, releaseID, options)
+}
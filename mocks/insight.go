@@ -35,6 +35,51 @@ func (m *MockInsights) EXPECT() *MockInsightsMockRecorder {
 	return m.recorder
 }
 
+// GetFlakyTests mocks base method.
+func (m *MockInsights) GetFlakyTests(ctx context.Context, projectSlug string) (*circleci.FlakyTests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlakyTests", ctx, projectSlug)
+	ret0, _ := ret[0].(*circleci.FlakyTests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlakyTests indicates an expected call of GetFlakyTests.
+func (mr *MockInsightsMockRecorder) GetFlakyTests(ctx, projectSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlakyTests", reflect.TypeOf((*MockInsights)(nil).GetFlakyTests), ctx, projectSlug)
+}
+
+// GetOrgSummary mocks base method.
+func (m *MockInsights) GetOrgSummary(ctx context.Context, orgSlug string, options circleci.InsightsGetOrgSummaryOptions) (*circleci.OrgSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgSummary", ctx, orgSlug, options)
+	ret0, _ := ret[0].(*circleci.OrgSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgSummary indicates an expected call of GetOrgSummary.
+func (mr *MockInsightsMockRecorder) GetOrgSummary(ctx, orgSlug, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgSummary", reflect.TypeOf((*MockInsights)(nil).GetOrgSummary), ctx, orgSlug, options)
+}
+
+// GetProjectSummary mocks base method.
+func (m *MockInsights) GetProjectSummary(ctx context.Context, projectSlug string, options circleci.InsightsGetProjectSummaryOptions) (*circleci.ProjectSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectSummary", ctx, projectSlug, options)
+	ret0, _ := ret[0].(*circleci.ProjectSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectSummary indicates an expected call of GetProjectSummary.
+func (mr *MockInsightsMockRecorder) GetProjectSummary(ctx, projectSlug, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectSummary", reflect.TypeOf((*MockInsights)(nil).GetProjectSummary), ctx, projectSlug, options)
+}
+
 // GetTestMetricsForWorkflows mocks base method.
 func (m *MockInsights) GetTestMetricsForWorkflows(ctx context.Context, projectSlug, workflowName string, options circleci.InsightsGetTestMetricsOptions) (*circleci.TestMetrics, error) {
 	m.ctrl.T.Helper()
@@ -50,19 +95,34 @@ func (mr *MockInsightsMockRecorder) GetTestMetricsForWorkflows(ctx, projectSlug,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestMetricsForWorkflows", reflect.TypeOf((*MockInsights)(nil).GetTestMetricsForWorkflows), ctx, projectSlug, workflowName, options)
 }
 
-// ListSummaryMetricsForWorkflows mocks base method.
-func (m *MockInsights) ListSummaryMetricsForWorkflows(ctx context.Context, projectSlug string, options circleci.InsightsListSummaryMetricsOptions) (*circleci.SummaryMetricsList, error) {
+// ListBranches mocks base method.
+func (m *MockInsights) ListBranches(ctx context.Context, projectSlug string, options circleci.InsightsListBranchesOptions) (*circleci.InsightsBranches, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSummaryMetricsForWorkflows", ctx, projectSlug, options)
-	ret0, _ := ret[0].(*circleci.SummaryMetricsList)
+	ret := m.ctrl.Call(m, "ListBranches", ctx, projectSlug, options)
+	ret0, _ := ret[0].(*circleci.InsightsBranches)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSummaryMetricsForWorkflows indicates an expected call of ListSummaryMetricsForWorkflows.
-func (mr *MockInsightsMockRecorder) ListSummaryMetricsForWorkflows(ctx, projectSlug, options interface{}) *gomock.Call {
+// ListBranches indicates an expected call of ListBranches.
+func (mr *MockInsightsMockRecorder) ListBranches(ctx, projectSlug, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSummaryMetricsForWorkflows", reflect.TypeOf((*MockInsights)(nil).ListSummaryMetricsForWorkflows), ctx, projectSlug, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBranches", reflect.TypeOf((*MockInsights)(nil).ListBranches), ctx, projectSlug, options)
+}
+
+// ListJobTimeSeries mocks base method.
+func (m *MockInsights) ListJobTimeSeries(ctx context.Context, projectSlug, workflowName string, options circleci.InsightsListJobTimeSeriesOptions) (*circleci.JobTimeSeriesList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobTimeSeries", ctx, projectSlug, workflowName, options)
+	ret0, _ := ret[0].(*circleci.JobTimeSeriesList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobTimeSeries indicates an expected call of ListJobTimeSeries.
+func (mr *MockInsightsMockRecorder) ListJobTimeSeries(ctx, projectSlug, workflowName, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobTimeSeries", reflect.TypeOf((*MockInsights)(nil).ListJobTimeSeries), ctx, projectSlug, workflowName, options)
 }
 
 // ListSummaryMetricsForWorkflowJobs mocks base method.
@@ -80,6 +140,51 @@ func (mr *MockInsightsMockRecorder) ListSummaryMetricsForWorkflowJobs(ctx, proje
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSummaryMetricsForWorkflowJobs", reflect.TypeOf((*MockInsights)(nil).ListSummaryMetricsForWorkflowJobs), ctx, projectSlug, workflowName, options)
 }
 
+// ListSummaryMetricsForWorkflowJobsInRange mocks base method.
+func (m *MockInsights) ListSummaryMetricsForWorkflowJobsInRange(ctx context.Context, projectSlug, workflowName string, options circleci.InsightsListSummaryMetricsInRangeOptions) (*circleci.RangeSummaryMetricsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSummaryMetricsForWorkflowJobsInRange", ctx, projectSlug, workflowName, options)
+	ret0, _ := ret[0].(*circleci.RangeSummaryMetricsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSummaryMetricsForWorkflowJobsInRange indicates an expected call of ListSummaryMetricsForWorkflowJobsInRange.
+func (mr *MockInsightsMockRecorder) ListSummaryMetricsForWorkflowJobsInRange(ctx, projectSlug, workflowName, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSummaryMetricsForWorkflowJobsInRange", reflect.TypeOf((*MockInsights)(nil).ListSummaryMetricsForWorkflowJobsInRange), ctx, projectSlug, workflowName, options)
+}
+
+// ListSummaryMetricsForWorkflows mocks base method.
+func (m *MockInsights) ListSummaryMetricsForWorkflows(ctx context.Context, projectSlug string, options circleci.InsightsListSummaryMetricsOptions) (*circleci.SummaryMetricsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSummaryMetricsForWorkflows", ctx, projectSlug, options)
+	ret0, _ := ret[0].(*circleci.SummaryMetricsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSummaryMetricsForWorkflows indicates an expected call of ListSummaryMetricsForWorkflows.
+func (mr *MockInsightsMockRecorder) ListSummaryMetricsForWorkflows(ctx, projectSlug, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSummaryMetricsForWorkflows", reflect.TypeOf((*MockInsights)(nil).ListSummaryMetricsForWorkflows), ctx, projectSlug, options)
+}
+
+// ListSummaryMetricsForWorkflowsInRange mocks base method.
+func (m *MockInsights) ListSummaryMetricsForWorkflowsInRange(ctx context.Context, projectSlug string, options circleci.InsightsListSummaryMetricsInRangeOptions) (*circleci.RangeSummaryMetricsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSummaryMetricsForWorkflowsInRange", ctx, projectSlug, options)
+	ret0, _ := ret[0].(*circleci.RangeSummaryMetricsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSummaryMetricsForWorkflowsInRange indicates an expected call of ListSummaryMetricsForWorkflowsInRange.
+func (mr *MockInsightsMockRecorder) ListSummaryMetricsForWorkflowsInRange(ctx, projectSlug, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSummaryMetricsForWorkflowsInRange", reflect.TypeOf((*MockInsights)(nil).ListSummaryMetricsForWorkflowsInRange), ctx, projectSlug, options)
+}
+
 // ListWorkflowJobRuns mocks base method.
 func (m *MockInsights) ListWorkflowJobRuns(ctx context.Context, projectSlug, workflowName, jobName string, options circleci.InsightsListWorkflowRunsOptions) (*circleci.WorkflowRunList, error) {
 	m.ctrl.T.Helper()
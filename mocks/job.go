@@ -6,6 +6,7 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -49,6 +50,35 @@ func (mr *MockJobsMockRecorder) Cancel(ctx, projectSlug, jobNumber interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockJobs)(nil).Cancel), ctx, projectSlug, jobNumber)
 }
 
+// CancelByID mocks base method.
+func (m *MockJobs) CancelByID(ctx context.Context, jobID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelByID", ctx, jobID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelByID indicates an expected call of CancelByID.
+func (mr *MockJobsMockRecorder) CancelByID(ctx, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelByID", reflect.TypeOf((*MockJobs)(nil).CancelByID), ctx, jobID)
+}
+
+// FindInWorkflow mocks base method.
+func (m *MockJobs) FindInWorkflow(ctx context.Context, workflowID, jobID string) (*circleci.WorkflowJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInWorkflow", ctx, workflowID, jobID)
+	ret0, _ := ret[0].(*circleci.WorkflowJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInWorkflow indicates an expected call of FindInWorkflow.
+func (mr *MockJobsMockRecorder) FindInWorkflow(ctx, workflowID, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInWorkflow", reflect.TypeOf((*MockJobs)(nil).FindInWorkflow), ctx, workflowID, jobID)
+}
+
 // Get mocks base method.
 func (m *MockJobs) Get(ctx context.Context, projectSlug, jobNumber string) (*circleci.Job, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +109,21 @@ func (mr *MockJobsMockRecorder) ListArtifacts(ctx, projectSlug, jobNumber interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifacts", reflect.TypeOf((*MockJobs)(nil).ListArtifacts), ctx, projectSlug, jobNumber)
 }
 
+// ListSteps mocks base method.
+func (m *MockJobs) ListSteps(ctx context.Context, projectSlug, jobNumber string) ([]*circleci.JobStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSteps", ctx, projectSlug, jobNumber)
+	ret0, _ := ret[0].([]*circleci.JobStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSteps indicates an expected call of ListSteps.
+func (mr *MockJobsMockRecorder) ListSteps(ctx, projectSlug, jobNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSteps", reflect.TypeOf((*MockJobs)(nil).ListSteps), ctx, projectSlug, jobNumber)
+}
+
 // ListTestMetadata mocks base method.
 func (m *MockJobs) ListTestMetadata(ctx context.Context, projectSlug, jobNumber string) (*circleci.TestMetadataList, error) {
 	m.ctrl.T.Helper()
@@ -93,3 +138,45 @@ func (mr *MockJobsMockRecorder) ListTestMetadata(ctx, projectSlug, jobNumber int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTestMetadata", reflect.TypeOf((*MockJobs)(nil).ListTestMetadata), ctx, projectSlug, jobNumber)
 }
+
+// Rerun mocks base method.
+func (m *MockJobs) Rerun(ctx context.Context, projectSlug, jobNumber string, options circleci.JobRerunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rerun", ctx, projectSlug, jobNumber, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rerun indicates an expected call of Rerun.
+func (mr *MockJobsMockRecorder) Rerun(ctx, projectSlug, jobNumber, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rerun", reflect.TypeOf((*MockJobs)(nil).Rerun), ctx, projectSlug, jobNumber, options)
+}
+
+// RerunByID mocks base method.
+func (m *MockJobs) RerunByID(ctx context.Context, workflowID, jobID string, options circleci.JobRerunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RerunByID", ctx, workflowID, jobID, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RerunByID indicates an expected call of RerunByID.
+func (mr *MockJobsMockRecorder) RerunByID(ctx, workflowID, jobID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RerunByID", reflect.TypeOf((*MockJobs)(nil).RerunByID), ctx, workflowID, jobID, options)
+}
+
+// StreamStepOutput mocks base method.
+func (m *MockJobs) StreamStepOutput(ctx context.Context, action *circleci.JobStepAction, w io.Writer, options circleci.JobStreamStepOutputOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamStepOutput", ctx, action, w, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamStepOutput indicates an expected call of StreamStepOutput.
+func (mr *MockJobsMockRecorder) StreamStepOutput(ctx, action, w, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamStepOutput", reflect.TypeOf((*MockJobs)(nil).StreamStepOutput), ctx, action, w, options)
+}
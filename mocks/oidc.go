@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockOIDC is a mock of OIDC interface.
+type MockOIDC struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCMockRecorder
+}
+
+// MockOIDCMockRecorder is the mock recorder for MockOIDC.
+type MockOIDCMockRecorder struct {
+	mock *MockOIDC
+}
+
+// NewMockOIDC creates a new mock instance.
+func NewMockOIDC(ctrl *gomock.Controller) *MockOIDC {
+	mock := &MockOIDC{ctrl: ctrl}
+	mock.recorder = &MockOIDCMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDC) EXPECT() *MockOIDCMockRecorder {
+	return m.recorder
+}
+
+// DeleteOrgClaims mocks base method.
+func (m *MockOIDC) DeleteOrgClaims(ctx context.Context, orgID string, options circleci.OIDCDeleteClaimsOptions) (*circleci.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrgClaims", ctx, orgID, options)
+	ret0, _ := ret[0].(*circleci.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrgClaims indicates an expected call of DeleteOrgClaims.
+func (mr *MockOIDCMockRecorder) DeleteOrgClaims(ctx, orgID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrgClaims", reflect.TypeOf((*MockOIDC)(nil).DeleteOrgClaims), ctx, orgID, options)
+}
+
+// DeleteProjectClaims mocks base method.
+func (m *MockOIDC) DeleteProjectClaims(ctx context.Context, orgID, projectID string, options circleci.OIDCDeleteClaimsOptions) (*circleci.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectClaims", ctx, orgID, projectID, options)
+	ret0, _ := ret[0].(*circleci.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectClaims indicates an expected call of DeleteProjectClaims.
+func (mr *MockOIDCMockRecorder) DeleteProjectClaims(ctx, orgID, projectID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectClaims", reflect.TypeOf((*MockOIDC)(nil).DeleteProjectClaims), ctx, orgID, projectID, options)
+}
+
+// GetOrgClaims mocks base method.
+func (m *MockOIDC) GetOrgClaims(ctx context.Context, orgID string) (*circleci.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgClaims", ctx, orgID)
+	ret0, _ := ret[0].(*circleci.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgClaims indicates an expected call of GetOrgClaims.
+func (mr *MockOIDCMockRecorder) GetOrgClaims(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgClaims", reflect.TypeOf((*MockOIDC)(nil).GetOrgClaims), ctx, orgID)
+}
+
+// GetProjectClaims mocks base method.
+func (m *MockOIDC) GetProjectClaims(ctx context.Context, orgID, projectID string) (*circleci.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectClaims", ctx, orgID, projectID)
+	ret0, _ := ret[0].(*circleci.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectClaims indicates an expected call of GetProjectClaims.
+func (mr *MockOIDCMockRecorder) GetProjectClaims(ctx, orgID, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectClaims", reflect.TypeOf((*MockOIDC)(nil).GetProjectClaims), ctx, orgID, projectID)
+}
+
+// PatchOrgClaims mocks base method.
+func (m *MockOIDC) PatchOrgClaims(ctx context.Context, orgID string, options circleci.OIDCPatchClaimsOptions) (*circleci.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchOrgClaims", ctx, orgID, options)
+	ret0, _ := ret[0].(*circleci.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchOrgClaims indicates an expected call of PatchOrgClaims.
+func (mr *MockOIDCMockRecorder) PatchOrgClaims(ctx, orgID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchOrgClaims", reflect.TypeOf((*MockOIDC)(nil).PatchOrgClaims), ctx, orgID, options)
+}
+
+// PatchProjectClaims mocks base method.
+func (m *MockOIDC) PatchProjectClaims(ctx context.Context, orgID, projectID string, options circleci.OIDCPatchClaimsOptions) (*circleci.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchProjectClaims", ctx, orgID, projectID, options)
+	ret0, _ := ret[0].(*circleci.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchProjectClaims indicates an expected call of PatchProjectClaims.
+func (mr *MockOIDCMockRecorder) PatchProjectClaims(ctx, orgID, projectID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchProjectClaims", reflect.TypeOf((*MockOIDC)(nil).PatchProjectClaims), ctx, orgID, projectID, options)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: orb.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockOrbs is a mock of Orbs interface.
+type MockOrbs struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrbsMockRecorder
+}
+
+// MockOrbsMockRecorder is the mock recorder for MockOrbs.
+type MockOrbsMockRecorder struct {
+	mock *MockOrbs
+}
+
+// NewMockOrbs creates a new mock instance.
+func NewMockOrbs(ctrl *gomock.Controller) *MockOrbs {
+	mock := &MockOrbs{ctrl: ctrl}
+	mock.recorder = &MockOrbsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrbs) EXPECT() *MockOrbsMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockOrbs) Get(ctx context.Context, name string) (*circleci.Orb, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(*circleci.Orb)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOrbsMockRecorder) Get(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrbs)(nil).Get), ctx, name)
+}
+
+// GetSource mocks base method.
+func (m *MockOrbs) GetSource(ctx context.Context, ref string) (*circleci.OrbVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSource", ctx, ref)
+	ret0, _ := ret[0].(*circleci.OrbVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSource indicates an expected call of GetSource.
+func (mr *MockOrbsMockRecorder) GetSource(ctx, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSource", reflect.TypeOf((*MockOrbs)(nil).GetSource), ctx, ref)
+}
+
+// List mocks base method.
+func (m *MockOrbs) List(ctx context.Context, namespace string, options circleci.OrbListOptions) (*circleci.OrbList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, namespace, options)
+	ret0, _ := ret[0].(*circleci.OrbList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOrbsMockRecorder) List(ctx, namespace, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrbs)(nil).List), ctx, namespace, options)
+}
+
+// ListCategories mocks base method.
+func (m *MockOrbs) ListCategories(ctx context.Context, options circleci.OrbCategoryListOptions) (*circleci.OrbCategoryList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx, options)
+	ret0, _ := ret[0].(*circleci.OrbCategoryList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockOrbsMockRecorder) ListCategories(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockOrbs)(nil).ListCategories), ctx, options)
+}
+
+// Publish mocks base method.
+func (m *MockOrbs) Publish(ctx context.Context, name string, options circleci.OrbPublishOptions) (*circleci.OrbVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, name, options)
+	ret0, _ := ret[0].(*circleci.OrbVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockOrbsMockRecorder) Publish(ctx, name, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockOrbs)(nil).Publish), ctx, name, options)
+}
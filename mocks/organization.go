@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockOrganizations is a mock of Organizations interface.
+type MockOrganizations struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationsMockRecorder
+}
+
+// MockOrganizationsMockRecorder is the mock recorder for MockOrganizations.
+type MockOrganizationsMockRecorder struct {
+	mock *MockOrganizations
+}
+
+// NewMockOrganizations creates a new mock instance.
+func NewMockOrganizations(ctrl *gomock.Controller) *MockOrganizations {
+	mock := &MockOrganizations{ctrl: ctrl}
+	mock.recorder = &MockOrganizationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizations) EXPECT() *MockOrganizationsMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockOrganizations) Get(ctx context.Context, orgSlugOrID string) (*circleci.OrganizationDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, orgSlugOrID)
+	ret0, _ := ret[0].(*circleci.OrganizationDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOrganizationsMockRecorder) Get(ctx, orgSlugOrID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrganizations)(nil).Get), ctx, orgSlugOrID)
+}
+
+// List mocks base method.
+func (m *MockOrganizations) List(ctx context.Context) ([]*circleci.OrganizationDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*circleci.OrganizationDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOrganizationsMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrganizations)(nil).List), ctx)
+}
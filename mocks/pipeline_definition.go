@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pipeline_definition.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockPipelineDefinitions is a mock of PipelineDefinitions interface.
+type MockPipelineDefinitions struct {
+	ctrl     *gomock.Controller
+	recorder *MockPipelineDefinitionsMockRecorder
+}
+
+// MockPipelineDefinitionsMockRecorder is the mock recorder for MockPipelineDefinitions.
+type MockPipelineDefinitionsMockRecorder struct {
+	mock *MockPipelineDefinitions
+}
+
+// NewMockPipelineDefinitions creates a new mock instance.
+func NewMockPipelineDefinitions(ctrl *gomock.Controller) *MockPipelineDefinitions {
+	mock := &MockPipelineDefinitions{ctrl: ctrl}
+	mock.recorder = &MockPipelineDefinitionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPipelineDefinitions) EXPECT() *MockPipelineDefinitionsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPipelineDefinitions) Create(ctx context.Context, projectID string, options circleci.PipelineDefinitionCreateOptions) (*circleci.PipelineDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, projectID, options)
+	ret0, _ := ret[0].(*circleci.PipelineDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPipelineDefinitionsMockRecorder) Create(ctx, projectID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPipelineDefinitions)(nil).Create), ctx, projectID, options)
+}
+
+// CreateTrigger mocks base method.
+func (m *MockPipelineDefinitions) CreateTrigger(ctx context.Context, projectID, definitionID string, options circleci.PipelineTriggerCreateOptions) (*circleci.PipelineTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrigger", ctx, projectID, definitionID, options)
+	ret0, _ := ret[0].(*circleci.PipelineTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrigger indicates an expected call of CreateTrigger.
+func (mr *MockPipelineDefinitionsMockRecorder) CreateTrigger(ctx, projectID, definitionID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrigger", reflect.TypeOf((*MockPipelineDefinitions)(nil).CreateTrigger), ctx, projectID, definitionID, options)
+}
+
+// Delete mocks base method.
+func (m *MockPipelineDefinitions) Delete(ctx context.Context, projectID, definitionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, projectID, definitionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPipelineDefinitionsMockRecorder) Delete(ctx, projectID, definitionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPipelineDefinitions)(nil).Delete), ctx, projectID, definitionID)
+}
+
+// DeleteTrigger mocks base method.
+func (m *MockPipelineDefinitions) DeleteTrigger(ctx context.Context, projectID, triggerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrigger", ctx, projectID, triggerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrigger indicates an expected call of DeleteTrigger.
+func (mr *MockPipelineDefinitionsMockRecorder) DeleteTrigger(ctx, projectID, triggerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*MockPipelineDefinitions)(nil).DeleteTrigger), ctx, projectID, triggerID)
+}
+
+// Get mocks base method.
+func (m *MockPipelineDefinitions) Get(ctx context.Context, projectID, definitionID string) (*circleci.PipelineDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, projectID, definitionID)
+	ret0, _ := ret[0].(*circleci.PipelineDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPipelineDefinitionsMockRecorder) Get(ctx, projectID, definitionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPipelineDefinitions)(nil).Get), ctx, projectID, definitionID)
+}
+
+// GetTrigger mocks base method.
+func (m *MockPipelineDefinitions) GetTrigger(ctx context.Context, projectID, triggerID string) (*circleci.PipelineTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrigger", ctx, projectID, triggerID)
+	ret0, _ := ret[0].(*circleci.PipelineTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrigger indicates an expected call of GetTrigger.
+func (mr *MockPipelineDefinitionsMockRecorder) GetTrigger(ctx, projectID, triggerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrigger", reflect.TypeOf((*MockPipelineDefinitions)(nil).GetTrigger), ctx, projectID, triggerID)
+}
+
+// List mocks base method.
+func (m *MockPipelineDefinitions) List(ctx context.Context, projectID string) (*circleci.PipelineDefinitionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID)
+	ret0, _ := ret[0].(*circleci.PipelineDefinitionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPipelineDefinitionsMockRecorder) List(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPipelineDefinitions)(nil).List), ctx, projectID)
+}
+
+// ListTriggers mocks base method.
+func (m *MockPipelineDefinitions) ListTriggers(ctx context.Context, projectID, definitionID string) (*circleci.PipelineTriggerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTriggers", ctx, projectID, definitionID)
+	ret0, _ := ret[0].(*circleci.PipelineTriggerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTriggers indicates an expected call of ListTriggers.
+func (mr *MockPipelineDefinitionsMockRecorder) ListTriggers(ctx, projectID, definitionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggers", reflect.TypeOf((*MockPipelineDefinitions)(nil).ListTriggers), ctx, projectID, definitionID)
+}
+
+// Update mocks base method.
+func (m *MockPipelineDefinitions) Update(ctx context.Context, projectID, definitionID string, options circleci.PipelineDefinitionUpdateOptions) (*circleci.PipelineDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, projectID, definitionID, options)
+	ret0, _ := ret[0].(*circleci.PipelineDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPipelineDefinitionsMockRecorder) Update(ctx, projectID, definitionID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPipelineDefinitions)(nil).Update), ctx, projectID, definitionID, options)
+}
+
+// UpdateTrigger mocks base method.
+func (m *MockPipelineDefinitions) UpdateTrigger(ctx context.Context, projectID, triggerID string, options circleci.PipelineTriggerUpdateOptions) (*circleci.PipelineTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrigger", ctx, projectID, triggerID, options)
+	ret0, _ := ret[0].(*circleci.PipelineTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrigger indicates an expected call of UpdateTrigger.
+func (mr *MockPipelineDefinitionsMockRecorder) UpdateTrigger(ctx, projectID, triggerID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrigger", reflect.TypeOf((*MockPipelineDefinitions)(nil).UpdateTrigger), ctx, projectID, triggerID, options)
+}
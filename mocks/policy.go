@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: policy.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockPolicies is a mock of Policies interface.
+type MockPolicies struct {
+	ctrl     *gomock.Controller
+	recorder *MockPoliciesMockRecorder
+}
+
+// MockPoliciesMockRecorder is the mock recorder for MockPolicies.
+type MockPoliciesMockRecorder struct {
+	mock *MockPolicies
+}
+
+// NewMockPolicies creates a new mock instance.
+func NewMockPolicies(ctrl *gomock.Controller) *MockPolicies {
+	mock := &MockPolicies{ctrl: ctrl}
+	mock.recorder = &MockPoliciesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicies) EXPECT() *MockPoliciesMockRecorder {
+	return m.recorder
+}
+
+// CreateBundle mocks base method.
+func (m *MockPolicies) CreateBundle(ctx context.Context, ownerID string, options circleci.PolicyCreateBundleOptions) (*circleci.PolicyBundleDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBundle", ctx, ownerID, options)
+	ret0, _ := ret[0].(*circleci.PolicyBundleDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBundle indicates an expected call of CreateBundle.
+func (mr *MockPoliciesMockRecorder) CreateBundle(ctx, ownerID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBundle", reflect.TypeOf((*MockPolicies)(nil).CreateBundle), ctx, ownerID, options)
+}
+
+// GetBundle mocks base method.
+func (m *MockPolicies) GetBundle(ctx context.Context, ownerID string) (circleci.PolicyBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBundle", ctx, ownerID)
+	ret0, _ := ret[0].(circleci.PolicyBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBundle indicates an expected call of GetBundle.
+func (mr *MockPoliciesMockRecorder) GetBundle(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBundle", reflect.TypeOf((*MockPolicies)(nil).GetBundle), ctx, ownerID)
+}
+
+// GetDecision mocks base method.
+func (m *MockPolicies) GetDecision(ctx context.Context, ownerID, decisionID string) (*circleci.PolicyDecisionLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecision", ctx, ownerID, decisionID)
+	ret0, _ := ret[0].(*circleci.PolicyDecisionLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecision indicates an expected call of GetDecision.
+func (mr *MockPoliciesMockRecorder) GetDecision(ctx, ownerID, decisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecision", reflect.TypeOf((*MockPolicies)(nil).GetDecision), ctx, ownerID, decisionID)
+}
+
+// GetDecisionBundle mocks base method.
+func (m *MockPolicies) GetDecisionBundle(ctx context.Context, ownerID, decisionID string) (circleci.PolicyBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecisionBundle", ctx, ownerID, decisionID)
+	ret0, _ := ret[0].(circleci.PolicyBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecisionBundle indicates an expected call of GetDecisionBundle.
+func (mr *MockPoliciesMockRecorder) GetDecisionBundle(ctx, ownerID, decisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecisionBundle", reflect.TypeOf((*MockPolicies)(nil).GetDecisionBundle), ctx, ownerID, decisionID)
+}
+
+// GetDecisionSettings mocks base method.
+func (m *MockPolicies) GetDecisionSettings(ctx context.Context, ownerID string) (*circleci.PolicyDecisionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecisionSettings", ctx, ownerID)
+	ret0, _ := ret[0].(*circleci.PolicyDecisionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecisionSettings indicates an expected call of GetDecisionSettings.
+func (mr *MockPoliciesMockRecorder) GetDecisionSettings(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecisionSettings", reflect.TypeOf((*MockPolicies)(nil).GetDecisionSettings), ctx, ownerID)
+}
+
+// ListDecisions mocks base method.
+func (m *MockPolicies) ListDecisions(ctx context.Context, ownerID string, options circleci.PolicyListDecisionsOptions) ([]*circleci.PolicyDecisionLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDecisions", ctx, ownerID, options)
+	ret0, _ := ret[0].([]*circleci.PolicyDecisionLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDecisions indicates an expected call of ListDecisions.
+func (mr *MockPoliciesMockRecorder) ListDecisions(ctx, ownerID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDecisions", reflect.TypeOf((*MockPolicies)(nil).ListDecisions), ctx, ownerID, options)
+}
+
+// UpdateDecisionSettings mocks base method.
+func (m *MockPolicies) UpdateDecisionSettings(ctx context.Context, ownerID string, options circleci.PolicyUpdateDecisionSettingsOptions) (*circleci.PolicyDecisionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDecisionSettings", ctx, ownerID, options)
+	ret0, _ := ret[0].(*circleci.PolicyDecisionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDecisionSettings indicates an expected call of UpdateDecisionSettings.
+func (mr *MockPoliciesMockRecorder) UpdateDecisionSettings(ctx, ownerID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDecisionSettings", reflect.TypeOf((*MockPolicies)(nil).UpdateDecisionSettings), ctx, ownerID, options)
+}
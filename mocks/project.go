@@ -35,6 +35,21 @@ func (m *MockProjects) EXPECT() *MockProjectsMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
+func (m *MockProjects) Create(ctx context.Context, provider, organization, project string) (*circleci.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, provider, organization, project)
+	ret0, _ := ret[0].(*circleci.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectsMockRecorder) Create(ctx, provider, organization, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjects)(nil).Create), ctx, provider, organization, project)
+}
+
 // CreateCheckoutKey mocks base method.
 func (m *MockProjects) CreateCheckoutKey(ctx context.Context, projectSlug string, options circleci.ProjectCreateCheckoutKeyOptions) (*circleci.ProjectCheckoutKey, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +108,21 @@ func (mr *MockProjectsMockRecorder) DeleteVariable(ctx, projectSlug, name interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariable", reflect.TypeOf((*MockProjects)(nil).DeleteVariable), ctx, projectSlug, name)
 }
 
+// Follow mocks base method.
+func (m *MockProjects) Follow(ctx context.Context, projectSlug string) (*circleci.ProjectFollowing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, projectSlug)
+	ret0, _ := ret[0].(*circleci.ProjectFollowing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockProjectsMockRecorder) Follow(ctx, projectSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockProjects)(nil).Follow), ctx, projectSlug)
+}
+
 // Get mocks base method.
 func (m *MockProjects) Get(ctx context.Context, projectSlug string) (*circleci.Project, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +243,21 @@ func (mr *MockProjectsMockRecorder) ListVariables(ctx, projectSlug, options inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVariables", reflect.TypeOf((*MockProjects)(nil).ListVariables), ctx, projectSlug, options)
 }
 
+// RunPipeline mocks base method.
+func (m *MockProjects) RunPipeline(ctx context.Context, projectSlug string, options circleci.ProjectRunPipelineOptions) (*circleci.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunPipeline", ctx, projectSlug, options)
+	ret0, _ := ret[0].(*circleci.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunPipeline indicates an expected call of RunPipeline.
+func (mr *MockProjectsMockRecorder) RunPipeline(ctx, projectSlug, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPipeline", reflect.TypeOf((*MockProjects)(nil).RunPipeline), ctx, projectSlug, options)
+}
+
 // TriggerPipeline mocks base method.
 func (m *MockProjects) TriggerPipeline(ctx context.Context, projectSlug string, options circleci.ProjectTriggerPipelineOptions) (*circleci.Pipeline, error) {
 	m.ctrl.T.Helper()
@@ -227,3 +272,18 @@ func (mr *MockProjectsMockRecorder) TriggerPipeline(ctx, projectSlug, options in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerPipeline", reflect.TypeOf((*MockProjects)(nil).TriggerPipeline), ctx, projectSlug, options)
 }
+
+// Unfollow mocks base method.
+func (m *MockProjects) Unfollow(ctx context.Context, projectSlug string) (*circleci.ProjectFollowing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, projectSlug)
+	ret0, _ := ret[0].(*circleci.ProjectFollowing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockProjectsMockRecorder) Unfollow(ctx, projectSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockProjects)(nil).Unfollow), ctx, projectSlug)
+}
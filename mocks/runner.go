@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: runner.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockRunner is a mock of Runner interface.
+type MockRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockRunnerMockRecorder
+}
+
+// MockRunnerMockRecorder is the mock recorder for MockRunner.
+type MockRunnerMockRecorder struct {
+	mock *MockRunner
+}
+
+// NewMockRunner creates a new mock instance.
+func NewMockRunner(ctrl *gomock.Controller) *MockRunner {
+	mock := &MockRunner{ctrl: ctrl}
+	mock.recorder = &MockRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRunner) EXPECT() *MockRunnerMockRecorder {
+	return m.recorder
+}
+
+// CreateResourceClass mocks base method.
+func (m *MockRunner) CreateResourceClass(ctx context.Context, options circleci.RunnerCreateResourceClassOptions) (*circleci.RunnerResourceClass, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResourceClass", ctx, options)
+	ret0, _ := ret[0].(*circleci.RunnerResourceClass)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResourceClass indicates an expected call of CreateResourceClass.
+func (mr *MockRunnerMockRecorder) CreateResourceClass(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceClass", reflect.TypeOf((*MockRunner)(nil).CreateResourceClass), ctx, options)
+}
+
+// CreateToken mocks base method.
+func (m *MockRunner) CreateToken(ctx context.Context, options circleci.RunnerCreateTokenOptions) (*circleci.RunnerToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", ctx, options)
+	ret0, _ := ret[0].(*circleci.RunnerToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockRunnerMockRecorder) CreateToken(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockRunner)(nil).CreateToken), ctx, options)
+}
+
+// DeleteResourceClass mocks base method.
+func (m *MockRunner) DeleteResourceClass(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceClass", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResourceClass indicates an expected call of DeleteResourceClass.
+func (mr *MockRunnerMockRecorder) DeleteResourceClass(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceClass", reflect.TypeOf((*MockRunner)(nil).DeleteResourceClass), ctx, id)
+}
+
+// DeleteToken mocks base method.
+func (m *MockRunner) DeleteToken(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteToken", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteToken indicates an expected call of DeleteToken.
+func (mr *MockRunnerMockRecorder) DeleteToken(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToken", reflect.TypeOf((*MockRunner)(nil).DeleteToken), ctx, id)
+}
+
+// GetRunningTaskCount mocks base method.
+func (m *MockRunner) GetRunningTaskCount(ctx context.Context, resourceClass string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningTaskCount", ctx, resourceClass)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningTaskCount indicates an expected call of GetRunningTaskCount.
+func (mr *MockRunnerMockRecorder) GetRunningTaskCount(ctx, resourceClass interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningTaskCount", reflect.TypeOf((*MockRunner)(nil).GetRunningTaskCount), ctx, resourceClass)
+}
+
+// GetUnclaimedTaskCount mocks base method.
+func (m *MockRunner) GetUnclaimedTaskCount(ctx context.Context, resourceClass string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnclaimedTaskCount", ctx, resourceClass)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnclaimedTaskCount indicates an expected call of GetUnclaimedTaskCount.
+func (mr *MockRunnerMockRecorder) GetUnclaimedTaskCount(ctx, resourceClass interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnclaimedTaskCount", reflect.TypeOf((*MockRunner)(nil).GetUnclaimedTaskCount), ctx, resourceClass)
+}
+
+// ListInstances mocks base method.
+func (m *MockRunner) ListInstances(ctx context.Context, options circleci.RunnerListInstancesOptions) (*circleci.RunnerInstanceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstances", ctx, options)
+	ret0, _ := ret[0].(*circleci.RunnerInstanceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstances indicates an expected call of ListInstances.
+func (mr *MockRunnerMockRecorder) ListInstances(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstances", reflect.TypeOf((*MockRunner)(nil).ListInstances), ctx, options)
+}
+
+// ListResourceClasses mocks base method.
+func (m *MockRunner) ListResourceClasses(ctx context.Context, options circleci.RunnerListResourceClassesOptions) (*circleci.RunnerResourceClassList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceClasses", ctx, options)
+	ret0, _ := ret[0].(*circleci.RunnerResourceClassList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceClasses indicates an expected call of ListResourceClasses.
+func (mr *MockRunnerMockRecorder) ListResourceClasses(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceClasses", reflect.TypeOf((*MockRunner)(nil).ListResourceClasses), ctx, options)
+}
+
+// ListTokens mocks base method.
+func (m *MockRunner) ListTokens(ctx context.Context, options circleci.RunnerListTokensOptions) (*circleci.RunnerTokenList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTokens", ctx, options)
+	ret0, _ := ret[0].(*circleci.RunnerTokenList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTokens indicates an expected call of ListTokens.
+func (mr *MockRunnerMockRecorder) ListTokens(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockRunner)(nil).ListTokens), ctx, options)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usage.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	circleci "github.com/grezar/go-circleci"
+)
+
+// MockUsage is a mock of Usage interface.
+type MockUsage struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageMockRecorder
+}
+
+// MockUsageMockRecorder is the mock recorder for MockUsage.
+type MockUsageMockRecorder struct {
+	mock *MockUsage
+}
+
+// NewMockUsage creates a new mock instance.
+func NewMockUsage(ctrl *gomock.Controller) *MockUsage {
+	mock := &MockUsage{ctrl: ctrl}
+	mock.recorder = &MockUsageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsage) EXPECT() *MockUsageMockRecorder {
+	return m.recorder
+}
+
+// CreateExportJob mocks base method.
+func (m *MockUsage) CreateExportJob(ctx context.Context, orgID string, options circleci.UsageCreateExportJobOptions) (*circleci.UsageExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExportJob", ctx, orgID, options)
+	ret0, _ := ret[0].(*circleci.UsageExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExportJob indicates an expected call of CreateExportJob.
+func (mr *MockUsageMockRecorder) CreateExportJob(ctx, orgID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExportJob", reflect.TypeOf((*MockUsage)(nil).CreateExportJob), ctx, orgID, options)
+}
+
+// DownloadExport mocks base method.
+func (m *MockUsage) DownloadExport(ctx context.Context, job *circleci.UsageExportJob, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadExport", ctx, job, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadExport indicates an expected call of DownloadExport.
+func (mr *MockUsageMockRecorder) DownloadExport(ctx, job, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadExport", reflect.TypeOf((*MockUsage)(nil).DownloadExport), ctx, job, w)
+}
+
+// GetExportJob mocks base method.
+func (m *MockUsage) GetExportJob(ctx context.Context, orgID, jobID string) (*circleci.UsageExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportJob", ctx, orgID, jobID)
+	ret0, _ := ret[0].(*circleci.UsageExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportJob indicates an expected call of GetExportJob.
+func (mr *MockUsageMockRecorder) GetExportJob(ctx, orgID, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportJob", reflect.TypeOf((*MockUsage)(nil).GetExportJob), ctx, orgID, jobID)
+}
+
+// WaitForExportJob mocks base method.
+func (m *MockUsage) WaitForExportJob(ctx context.Context, orgID, jobID string, interval time.Duration) (*circleci.UsageExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForExportJob", ctx, orgID, jobID, interval)
+	ret0, _ := ret[0].(*circleci.UsageExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForExportJob indicates an expected call of WaitForExportJob.
+func (mr *MockUsageMockRecorder) WaitForExportJob(ctx, orgID, jobID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForExportJob", reflect.TypeOf((*MockUsage)(nil).WaitForExportJob), ctx, orgID, jobID, interval)
+}
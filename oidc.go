@@ -0,0 +1,176 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type OIDC interface {
+	GetOrgClaims(ctx context.Context, orgID string) (*OIDCClaims, error)
+	PatchOrgClaims(ctx context.Context, orgID string, options OIDCPatchClaimsOptions) (*OIDCClaims, error)
+	DeleteOrgClaims(ctx context.Context, orgID string, options OIDCDeleteClaimsOptions) (*OIDCClaims, error)
+	GetProjectClaims(ctx context.Context, orgID, projectID string) (*OIDCClaims, error)
+	PatchProjectClaims(ctx context.Context, orgID, projectID string, options OIDCPatchClaimsOptions) (*OIDCClaims, error)
+	DeleteProjectClaims(ctx context.Context, orgID, projectID string, options OIDCDeleteClaimsOptions) (*OIDCClaims, error)
+}
+
+// oidc implements OIDC interface
+type oidc struct {
+	client *Client
+}
+
+type OIDCClaimType string
+
+const (
+	OIDCClaimAudience OIDCClaimType = "audience"
+	OIDCClaimTTL      OIDCClaimType = "ttl"
+)
+
+type OIDCClaims struct {
+	OrgID             string    `json:"org_id"`
+	ProjectID         string    `json:"project_id,omitempty"`
+	Audience          []string  `json:"audience"`
+	AudienceUpdatedAt time.Time `json:"audience_updated_at"`
+	TTL               string    `json:"ttl"`
+	TTLUpdatedAt      time.Time `json:"ttl_updated_at"`
+}
+
+type OIDCPatchClaimsOptions struct {
+	Audience []string `json:"audience,omitempty"`
+	// TTL is a duration string such as "1h" or "30m".
+	TTL *string `json:"ttl,omitempty"`
+}
+
+func (o OIDCPatchClaimsOptions) valid() error {
+	if len(o.Audience) == 0 && !validString(o.TTL) {
+		return ErrRequiredOIDCClaims
+	}
+
+	return nil
+}
+
+type OIDCDeleteClaimsOptions struct {
+	Claims []OIDCClaimType
+}
+
+func (o OIDCDeleteClaimsOptions) valid() error {
+	if len(o.Claims) == 0 {
+		return ErrRequiredOIDCClaims
+	}
+
+	return nil
+}
+
+// query returns the claims to delete in the comma separated form the API
+// expects.
+func (o OIDCDeleteClaimsOptions) query() string {
+	claims := make([]string, 0, len(o.Claims))
+	for _, c := range o.Claims {
+		claims = append(claims, string(c))
+	}
+
+	v := url.Values{}
+	v.Set("claims", strings.Join(claims, ","))
+	return v.Encode()
+}
+
+func (s *oidc) GetOrgClaims(ctx context.Context, orgID string) (*OIDCClaims, error) {
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	u := fmt.Sprintf("org/%s/oidc-custom-claims", orgID)
+	return s.claims(ctx, "GET", u, nil)
+}
+
+func (s *oidc) PatchOrgClaims(ctx context.Context, orgID string, options OIDCPatchClaimsOptions) (*OIDCClaims, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	u := fmt.Sprintf("org/%s/oidc-custom-claims", orgID)
+	return s.claims(ctx, "PATCH", u, &options)
+}
+
+func (s *oidc) DeleteOrgClaims(ctx context.Context, orgID string, options OIDCDeleteClaimsOptions) (*OIDCClaims, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	u := fmt.Sprintf("org/%s/oidc-custom-claims?%s", orgID, options.query())
+	return s.claims(ctx, "DELETE", u, nil)
+}
+
+func (s *oidc) GetProjectClaims(ctx context.Context, orgID, projectID string) (*OIDCClaims, error) {
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	u := fmt.Sprintf("org/%s/project/%s/oidc-custom-claims", orgID, projectID)
+	return s.claims(ctx, "GET", u, nil)
+}
+
+func (s *oidc) PatchProjectClaims(ctx context.Context, orgID, projectID string, options OIDCPatchClaimsOptions) (*OIDCClaims, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	u := fmt.Sprintf("org/%s/project/%s/oidc-custom-claims", orgID, projectID)
+	return s.claims(ctx, "PATCH", u, &options)
+}
+
+func (s *oidc) DeleteProjectClaims(ctx context.Context, orgID, projectID string, options OIDCDeleteClaimsOptions) (*OIDCClaims, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	u := fmt.Sprintf("org/%s/project/%s/oidc-custom-claims?%s", orgID, projectID, options.query())
+	return s.claims(ctx, "DELETE", u, nil)
+}
+
+func (s *oidc) claims(ctx context.Context, method, u string, v interface{}) (*OIDCClaims, error) {
+	req, err := s.client.newRequest(method, u, v)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &OIDCClaims{}
+	err = s.client.do(ctx, req, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
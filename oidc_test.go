@@ -0,0 +1,201 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_oidc_GetOrgClaims(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+
+	mux.HandleFunc(fmt.Sprintf("/org/%s/oidc-custom-claims", orgID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"org_id": "org1", "audience": ["sts.amazonaws.com"], "ttl": "1h"}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.OIDC.GetOrgClaims(ctx, orgID)
+	if err != nil {
+		t.Errorf("OIDC.GetOrgClaims got error: %v", err)
+	}
+
+	want := &OIDCClaims{
+		OrgID:    orgID,
+		Audience: []string{"sts.amazonaws.com"},
+		TTL:      "1h",
+	}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("OIDC.GetOrgClaims got %+v, want %+v", c, want)
+	}
+}
+
+func Test_oidc_PatchOrgClaims(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+
+	mux.HandleFunc(fmt.Sprintf("/org/%s/oidc-custom-claims", orgID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"audience":["sts.amazonaws.com"],"ttl":"30m"}`+"\n")
+		fmt.Fprint(w, `{"org_id": "org1", "audience": ["sts.amazonaws.com"], "ttl": "30m"}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.OIDC.PatchOrgClaims(ctx, orgID, OIDCPatchClaimsOptions{
+		Audience: []string{"sts.amazonaws.com"},
+		TTL:      String("30m"),
+	})
+	if err != nil {
+		t.Errorf("OIDC.PatchOrgClaims got error: %v", err)
+	}
+
+	want := &OIDCClaims{
+		OrgID:    orgID,
+		Audience: []string{"sts.amazonaws.com"},
+		TTL:      "30m",
+	}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("OIDC.PatchOrgClaims got %+v, want %+v", c, want)
+	}
+}
+
+func Test_oidc_DeleteOrgClaims(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+
+	mux.HandleFunc(fmt.Sprintf("/org/%s/oidc-custom-claims", orgID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "claims", "audience,ttl")
+		fmt.Fprint(w, `{"org_id": "org1"}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.OIDC.DeleteOrgClaims(ctx, orgID, OIDCDeleteClaimsOptions{
+		Claims: []OIDCClaimType{OIDCClaimAudience, OIDCClaimTTL},
+	})
+	if err != nil {
+		t.Errorf("OIDC.DeleteOrgClaims got error: %v", err)
+	}
+
+	want := &OIDCClaims{OrgID: orgID}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("OIDC.DeleteOrgClaims got %+v, want %+v", c, want)
+	}
+}
+
+func Test_oidc_GetProjectClaims(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+	projectID := "prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/org/%s/project/%s/oidc-custom-claims", orgID, projectID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"org_id": "org1", "project_id": "prj1", "ttl": "1h"}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.OIDC.GetProjectClaims(ctx, orgID, projectID)
+	if err != nil {
+		t.Errorf("OIDC.GetProjectClaims got error: %v", err)
+	}
+
+	want := &OIDCClaims{
+		OrgID:     orgID,
+		ProjectID: projectID,
+		TTL:       "1h",
+	}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("OIDC.GetProjectClaims got %+v, want %+v", c, want)
+	}
+}
+
+func Test_oidc_PatchProjectClaims(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+	projectID := "prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/org/%s/project/%s/oidc-custom-claims", orgID, projectID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"audience":["aud1"]}`+"\n")
+		fmt.Fprint(w, `{"org_id": "org1", "project_id": "prj1", "audience": ["aud1"]}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.OIDC.PatchProjectClaims(ctx, orgID, projectID, OIDCPatchClaimsOptions{
+		Audience: []string{"aud1"},
+	})
+	if err != nil {
+		t.Errorf("OIDC.PatchProjectClaims got error: %v", err)
+	}
+
+	want := &OIDCClaims{
+		OrgID:     orgID,
+		ProjectID: projectID,
+		Audience:  []string{"aud1"},
+	}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("OIDC.PatchProjectClaims got %+v, want %+v", c, want)
+	}
+}
+
+func Test_oidc_DeleteProjectClaims(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+	projectID := "prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/org/%s/project/%s/oidc-custom-claims", orgID, projectID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "claims", "ttl")
+		fmt.Fprint(w, `{"org_id": "org1", "project_id": "prj1"}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.OIDC.DeleteProjectClaims(ctx, orgID, projectID, OIDCDeleteClaimsOptions{
+		Claims: []OIDCClaimType{OIDCClaimTTL},
+	})
+	if err != nil {
+		t.Errorf("OIDC.DeleteProjectClaims got error: %v", err)
+	}
+
+	want := &OIDCClaims{
+		OrgID:     orgID,
+		ProjectID: projectID,
+	}
+
+	if !cmp.Equal(c, want) {
+		t.Errorf("OIDC.DeleteProjectClaims got %+v, want %+v", c, want)
+	}
+}
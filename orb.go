@@ -0,0 +1,337 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"regexp"
+	"time"
+)
+
+// Orbs talks to the orb registry. Unlike the other services it is backed by
+// the GraphQL API, which is the only place orb data is exposed.
+type Orbs interface {
+	List(ctx context.Context, namespace string, options OrbListOptions) (*OrbList, error)
+	Get(ctx context.Context, name string) (*Orb, error)
+	GetSource(ctx context.Context, ref string) (*OrbVersion, error)
+	Publish(ctx context.Context, name string, options OrbPublishOptions) (*OrbVersion, error)
+	ListCategories(ctx context.Context, options OrbCategoryListOptions) (*OrbCategoryList, error)
+}
+
+// orbs implements Orbs interface
+type orbs struct {
+	client *Client
+}
+
+type Orb struct {
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	HighestVersion string         `json:"highestVersion"`
+	Statistics     *OrbStatistics `json:"statistics"`
+	Versions       []*OrbVersion  `json:"versions"`
+	Categories     []*OrbCategory `json:"categories"`
+}
+
+type OrbStatistics struct {
+	Last30DaysBuildCount        int `json:"last30DaysBuildCount"`
+	Last30DaysProjectCount      int `json:"last30DaysProjectCount"`
+	Last30DaysOrganizationCount int `json:"last30DaysOrganizationCount"`
+}
+
+type OrbVersion struct {
+	ID        string    `json:"id"`
+	Version   string    `json:"version"`
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type OrbCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type OrbList struct {
+	Items         []*Orb `json:"items"`
+	NextPageToken string `json:"next_page_token"`
+}
+
+type OrbListOptions struct {
+	PageToken *string
+}
+
+type orbPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+const orbListQuery = `query ($namespace: String, $after: String!) {
+  registryNamespace(name: $namespace) {
+    orbs(first: 20, after: $after) {
+      edges {
+        cursor
+        node {
+          id
+          name
+          createdAt
+          highestVersion
+          statistics { last30DaysBuildCount last30DaysProjectCount last30DaysOrganizationCount }
+        }
+      }
+      pageInfo { hasNextPage }
+    }
+  }
+}`
+
+func (s *orbs) List(ctx context.Context, namespace string, options OrbListOptions) (*OrbList, error) {
+	if !validString(&namespace) {
+		return nil, ErrRequiredOrbNamespace
+	}
+
+	var after string
+	if options.PageToken != nil {
+		after = *options.PageToken
+	}
+
+	var data struct {
+		RegistryNamespace *struct {
+			Orbs struct {
+				Edges []struct {
+					Cursor string `json:"cursor"`
+					Node   *Orb   `json:"node"`
+				} `json:"edges"`
+				PageInfo orbPageInfo `json:"pageInfo"`
+			} `json:"orbs"`
+		} `json:"registryNamespace"`
+	}
+	err := s.client.doGraphQL(ctx, orbListQuery, map[string]interface{}{
+		"namespace": namespace,
+		"after":     after,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.RegistryNamespace == nil {
+		return nil, ErrNotFound
+	}
+
+	ol := &OrbList{Items: []*Orb{}}
+	edges := data.RegistryNamespace.Orbs.Edges
+	for _, e := range edges {
+		ol.Items = append(ol.Items, e.Node)
+	}
+	if data.RegistryNamespace.Orbs.PageInfo.HasNextPage && len(edges) > 0 {
+		ol.NextPageToken = edges[len(edges)-1].Cursor
+	}
+
+	return ol, nil
+}
+
+const orbGetQuery = `query ($name: String!) {
+  orb(name: $name) {
+    id
+    name
+    createdAt
+    highestVersion
+    statistics { last30DaysBuildCount last30DaysProjectCount last30DaysOrganizationCount }
+    versions { id version createdAt }
+    categories { id name }
+  }
+}`
+
+// Get returns the metadata, usage statistics and versions of the orb named
+// name, e.g. circleci/node. Sources are left out, see GetSource.
+func (s *orbs) Get(ctx context.Context, name string) (*Orb, error) {
+	if !validString(&name) {
+		return nil, ErrRequiredOrbName
+	}
+
+	var data struct {
+		Orb *Orb `json:"orb"`
+	}
+	err := s.client.doGraphQL(ctx, orbGetQuery, map[string]interface{}{
+		"name": name,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Orb == nil {
+		return nil, ErrNotFound
+	}
+
+	return data.Orb, nil
+}
+
+const orbSourceQuery = `query ($orbVersionRef: String!) {
+  orbVersion(orbVersionRef: $orbVersionRef) {
+    id
+    version
+    source
+    createdAt
+  }
+}`
+
+// GetSource returns the source of the orb version referenced by ref, e.g.
+// circleci/node@5.0.0. A ref without a version resolves to the latest one.
+func (s *orbs) GetSource(ctx context.Context, ref string) (*OrbVersion, error) {
+	if !validString(&ref) {
+		return nil, ErrRequiredOrbRef
+	}
+
+	var data struct {
+		OrbVersion *OrbVersion `json:"orbVersion"`
+	}
+	err := s.client.doGraphQL(ctx, orbSourceQuery, map[string]interface{}{
+		"orbVersionRef": ref,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.OrbVersion == nil {
+		return nil, ErrNotFound
+	}
+
+	return data.OrbVersion, nil
+}
+
+type OrbPublishOptions struct {
+	// Version is either a semantic version such as 1.2.3 or a development
+	// version such as dev:alpha.
+	Version *string
+	// Source is the orb YAML.
+	Source *string
+}
+
+var orbVersion = regexp.MustCompile(`^(\d+\.\d+\.\d+|dev:[\w.-]+)$`)
+
+func (o OrbPublishOptions) valid() error {
+	if !validString(o.Version) {
+		return ErrRequiredOrbVersion
+	}
+
+	if !orbVersion.MatchString(*o.Version) {
+		return ErrInvalidOrbVersion
+	}
+
+	if !validString(o.Source) {
+		return ErrRequiredOrbSource
+	}
+
+	return nil
+}
+
+const orbIDQuery = `query ($name: String!) {
+  orb(name: $name) {
+    id
+  }
+}`
+
+const orbPublishMutation = `mutation ($orbId: UUID!, $config: String!, $version: String!) {
+  publishOrb(orbId: $orbId, orbYaml: $config, version: $version) {
+    orb { id version source createdAt }
+    errors { message }
+  }
+}`
+
+// Publish publishes a new version of the orb named name, which must already
+// exist in the registry.
+func (s *orbs) Publish(ctx context.Context, name string, options OrbPublishOptions) (*OrbVersion, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&name) {
+		return nil, ErrRequiredOrbName
+	}
+
+	var orb struct {
+		Orb *struct {
+			ID string `json:"id"`
+		} `json:"orb"`
+	}
+	err := s.client.doGraphQL(ctx, orbIDQuery, map[string]interface{}{
+		"name": name,
+	}, &orb)
+	if err != nil {
+		return nil, err
+	}
+
+	if orb.Orb == nil {
+		return nil, ErrNotFound
+	}
+
+	var data struct {
+		PublishOrb struct {
+			Orb    *OrbVersion   `json:"orb"`
+			Errors GraphQLErrors `json:"errors"`
+		} `json:"publishOrb"`
+	}
+	err = s.client.doGraphQL(ctx, orbPublishMutation, map[string]interface{}{
+		"orbId":   orb.Orb.ID,
+		"config":  *options.Source,
+		"version": *options.Version,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data.PublishOrb.Errors) > 0 {
+		return nil, data.PublishOrb.Errors
+	}
+
+	return data.PublishOrb.Orb, nil
+}
+
+type OrbCategoryList struct {
+	Items         []*OrbCategory `json:"items"`
+	NextPageToken string         `json:"next_page_token"`
+}
+
+type OrbCategoryListOptions struct {
+	PageToken *string
+}
+
+const orbCategoryListQuery = `query ($after: String!) {
+  orbCategories(first: 20, after: $after) {
+    edges {
+      cursor
+      node { id name }
+    }
+    pageInfo { hasNextPage }
+  }
+}`
+
+func (s *orbs) ListCategories(ctx context.Context, options OrbCategoryListOptions) (*OrbCategoryList, error) {
+	var after string
+	if options.PageToken != nil {
+		after = *options.PageToken
+	}
+
+	var data struct {
+		OrbCategories struct {
+			Edges []struct {
+				Cursor string       `json:"cursor"`
+				Node   *OrbCategory `json:"node"`
+			} `json:"edges"`
+			PageInfo orbPageInfo `json:"pageInfo"`
+		} `json:"orbCategories"`
+	}
+	err := s.client.doGraphQL(ctx, orbCategoryListQuery, map[string]interface{}{
+		"after": after,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	cl := &OrbCategoryList{Items: []*OrbCategory{}}
+	edges := data.OrbCategories.Edges
+	for _, e := range edges {
+		cl.Items = append(cl.Items, e.Node)
+	}
+	if data.OrbCategories.PageInfo.HasNextPage && len(edges) > 0 {
+		cl.NextPageToken = edges[len(edges)-1].Cursor
+	}
+
+	return cl, nil
+}
@@ -0,0 +1,242 @@
+package circleci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func decodeGraphQLRequest(t *testing.T, r *http.Request) *graphQLRequest {
+	t.Helper()
+	gr := &graphQLRequest{}
+	if err := json.NewDecoder(r.Body).Decode(gr); err != nil {
+		t.Errorf("Error reading GraphQL request: %v", err)
+	}
+	return gr
+}
+
+func Test_orbs_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(graphQLPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Authorization", client.token)
+		gr := decodeGraphQLRequest(t, r)
+		want := map[string]interface{}{"namespace": "circleci", "after": "cursor0"}
+		if !cmp.Equal(gr.Variables, want) {
+			t.Errorf("GraphQL variables got %+v, want %+v", gr.Variables, want)
+		}
+		fmt.Fprint(w, `{"data": {"registryNamespace": {"orbs": {
+			"edges": [{"cursor": "cursor1", "node": {"id": "orb1", "name": "circleci/node", "highestVersion": "5.0.0", "statistics": {"last30DaysBuildCount": 10}}}],
+			"pageInfo": {"hasNextPage": true}
+		}}}}`)
+	})
+
+	ctx := context.Background()
+	ol, err := client.Orbs.List(ctx, "circleci", OrbListOptions{
+		PageToken: String("cursor0"),
+	})
+	if err != nil {
+		t.Errorf("Orbs.List got error: %v", err)
+	}
+
+	want := &OrbList{
+		Items: []*Orb{
+			{
+				ID:             "orb1",
+				Name:           "circleci/node",
+				HighestVersion: "5.0.0",
+				Statistics: &OrbStatistics{
+					Last30DaysBuildCount: 10,
+				},
+			},
+		},
+		NextPageToken: "cursor1",
+	}
+
+	if !cmp.Equal(ol, want) {
+		t.Errorf("Orbs.List got %+v, want %+v", ol, want)
+	}
+}
+
+func Test_orbs_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(graphQLPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		gr := decodeGraphQLRequest(t, r)
+		if gr.Variables["name"] == "circleci/missing" {
+			fmt.Fprint(w, `{"data": {"orb": null}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"orb": {"id": "orb1", "name": "circleci/node", "versions": [{"id": "v1", "version": "5.0.0"}], "categories": [{"id": "c1", "name": "Language/Framework"}]}}}`)
+	})
+
+	ctx := context.Background()
+	o, err := client.Orbs.Get(ctx, "circleci/node")
+	if err != nil {
+		t.Errorf("Orbs.Get got error: %v", err)
+	}
+
+	want := &Orb{
+		ID:         "orb1",
+		Name:       "circleci/node",
+		Versions:   []*OrbVersion{{ID: "v1", Version: "5.0.0"}},
+		Categories: []*OrbCategory{{ID: "c1", Name: "Language/Framework"}},
+	}
+
+	if !cmp.Equal(o, want) {
+		t.Errorf("Orbs.Get got %+v, want %+v", o, want)
+	}
+
+	if _, err := client.Orbs.Get(ctx, "circleci/missing"); err != ErrNotFound {
+		t.Errorf("Orbs.Get got error %v, want %v", err, ErrNotFound)
+	}
+}
+
+func Test_orbs_GetSource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(graphQLPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		gr := decodeGraphQLRequest(t, r)
+		if gr.Variables["orbVersionRef"] != "circleci/node@5.0.0" {
+			t.Errorf("GraphQL orbVersionRef got %v", gr.Variables["orbVersionRef"])
+		}
+		fmt.Fprint(w, `{"data": {"orbVersion": {"id": "v1", "version": "5.0.0", "source": "version: 2.1\n"}}}`)
+	})
+
+	ctx := context.Background()
+	v, err := client.Orbs.GetSource(ctx, "circleci/node@5.0.0")
+	if err != nil {
+		t.Errorf("Orbs.GetSource got error: %v", err)
+	}
+
+	want := &OrbVersion{
+		ID:      "v1",
+		Version: "5.0.0",
+		Source:  "version: 2.1\n",
+	}
+
+	if !cmp.Equal(v, want) {
+		t.Errorf("Orbs.GetSource got %+v, want %+v", v, want)
+	}
+}
+
+func Test_orbs_Publish(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(graphQLPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		gr := decodeGraphQLRequest(t, r)
+		if !strings.HasPrefix(gr.Query, "mutation") {
+			fmt.Fprint(w, `{"data": {"orb": {"id": "orb1"}}}`)
+			return
+		}
+		want := map[string]interface{}{"orbId": "orb1", "config": "version: 2.1\n", "version": "dev:alpha"}
+		if !cmp.Equal(gr.Variables, want) {
+			t.Errorf("GraphQL variables got %+v, want %+v", gr.Variables, want)
+		}
+		fmt.Fprint(w, `{"data": {"publishOrb": {"orb": {"id": "v1", "version": "dev:alpha"}, "errors": []}}}`)
+	})
+
+	ctx := context.Background()
+	v, err := client.Orbs.Publish(ctx, "org1/orb1", OrbPublishOptions{
+		Version: String("dev:alpha"),
+		Source:  String("version: 2.1\n"),
+	})
+	if err != nil {
+		t.Errorf("Orbs.Publish got error: %v", err)
+	}
+
+	want := &OrbVersion{
+		ID:      "v1",
+		Version: "dev:alpha",
+	}
+
+	if !cmp.Equal(v, want) {
+		t.Errorf("Orbs.Publish got %+v, want %+v", v, want)
+	}
+
+	_, err = client.Orbs.Publish(ctx, "org1/orb1", OrbPublishOptions{
+		Version: String("latest"),
+		Source:  String("version: 2.1\n"),
+	})
+	if err != ErrInvalidOrbVersion {
+		t.Errorf("Orbs.Publish got error %v, want %v", err, ErrInvalidOrbVersion)
+	}
+}
+
+func Test_orbs_Publish_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(graphQLPath, func(w http.ResponseWriter, r *http.Request) {
+		gr := decodeGraphQLRequest(t, r)
+		if !strings.HasPrefix(gr.Query, "mutation") {
+			fmt.Fprint(w, `{"data": {"orb": {"id": "orb1"}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"publishOrb": {"orb": null, "errors": [{"message": "version already exists"}]}}}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Orbs.Publish(ctx, "org1/orb1", OrbPublishOptions{
+		Version: String("1.0.0"),
+		Source:  String("version: 2.1\n"),
+	})
+	if err == nil || err.Error() != "version already exists" {
+		t.Errorf("Orbs.Publish got error %v, want %q", err, "version already exists")
+	}
+}
+
+func Test_orbs_ListCategories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(graphQLPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data": {"orbCategories": {
+			"edges": [{"cursor": "cursor1", "node": {"id": "c1", "name": "Testing"}}],
+			"pageInfo": {"hasNextPage": false}
+		}}}`)
+	})
+
+	ctx := context.Background()
+	cl, err := client.Orbs.ListCategories(ctx, OrbCategoryListOptions{})
+	if err != nil {
+		t.Errorf("Orbs.ListCategories got error: %v", err)
+	}
+
+	want := &OrbCategoryList{
+		Items: []*OrbCategory{{ID: "c1", Name: "Testing"}},
+	}
+
+	if !cmp.Equal(cl, want) {
+		t.Errorf("Orbs.ListCategories got %+v, want %+v", cl, want)
+	}
+}
+
+func Test_Client_doGraphQL_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(graphQLPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "first"}, {"message": "second"}]}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Orbs.ListCategories(ctx, OrbCategoryListOptions{})
+	if _, ok := err.(GraphQLErrors); !ok || err.Error() != "first; second" {
+		t.Errorf("Client.doGraphQL got error %v, want GraphQLErrors", err)
+	}
+}
@@ -0,0 +1,176 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+type Organizations interface {
+	Get(ctx context.Context, orgSlugOrID string) (*OrganizationDetail, error)
+	List(ctx context.Context) ([]*OrganizationDetail, error)
+}
+
+// organizations implements Organizations interface
+type organizations struct {
+	client *Client
+}
+
+type OrganizationDetail struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Slug    string `json:"slug"`
+	VcsType string `json:"vcs_type"`
+}
+
+func (s *organizations) Get(ctx context.Context, orgSlugOrID string) (*OrganizationDetail, error) {
+	if !validString(&orgSlugOrID) {
+		return nil, ErrRequiredEitherOrganizationIDOrSlug
+	}
+
+	u := fmt.Sprintf("organization/%s", orgSlugOrID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &OrganizationDetail{}
+	err = s.client.do(ctx, req, o)
+	if err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// List returns the organizations the caller is a member of. The API only
+// exposes them through the collaborations of the current user.
+func (s *organizations) List(ctx context.Context) ([]*OrganizationDetail, error) {
+	cs, err := s.client.Users.Collaborations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ol := make([]*OrganizationDetail, 0, len(cs))
+	for _, c := range cs {
+		ol = append(ol, &OrganizationDetail{
+			ID:      c.ID,
+			Name:    c.Name,
+			Slug:    c.Slug,
+			VcsType: c.VcsType,
+		})
+	}
+
+	return ol, nil
+}
+
+var organizationID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// OrganizationResolver maps organization slugs and IDs to each other. Lookups
+// are cached for the lifetime of the resolver, so it can be shared and used
+// wherever an owner ID or slug is required.
+type OrganizationResolver struct {
+	orgs Organizations
+
+	mu     sync.Mutex
+	bySlug map[string]*OrganizationDetail
+	byID   map[string]*OrganizationDetail
+}
+
+func NewOrganizationResolver(orgs Organizations) *OrganizationResolver {
+	return &OrganizationResolver{
+		orgs:   orgs,
+		bySlug: map[string]*OrganizationDetail{},
+		byID:   map[string]*OrganizationDetail{},
+	}
+}
+
+// Resolve returns the organization identified by slugOrID.
+func (r *OrganizationResolver) Resolve(ctx context.Context, slugOrID string) (*OrganizationDetail, error) {
+	if !validString(&slugOrID) {
+		return nil, ErrRequiredEitherOrganizationIDOrSlug
+	}
+
+	if o, ok := r.cached(slugOrID); ok {
+		return o, nil
+	}
+
+	o, err := r.orgs.Get(ctx, slugOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.add(o)
+	r.mu.Unlock()
+
+	return o, nil
+}
+
+// ID returns the ID of the organization identified by slugOrID. IDs are
+// returned as is without calling the API.
+func (r *OrganizationResolver) ID(ctx context.Context, slugOrID string) (string, error) {
+	if organizationID.MatchString(slugOrID) {
+		return slugOrID, nil
+	}
+
+	o, err := r.Resolve(ctx, slugOrID)
+	if err != nil {
+		return "", err
+	}
+
+	return o.ID, nil
+}
+
+// Slug returns the slug of the organization identified by slugOrID.
+func (r *OrganizationResolver) Slug(ctx context.Context, slugOrID string) (string, error) {
+	if validString(&slugOrID) && !organizationID.MatchString(slugOrID) {
+		return slugOrID, nil
+	}
+
+	o, err := r.Resolve(ctx, slugOrID)
+	if err != nil {
+		return "", err
+	}
+
+	return o.Slug, nil
+}
+
+// Prime fills the cache with every organization the caller is a member of.
+func (r *OrganizationResolver) Prime(ctx context.Context) error {
+	ol, err := r.orgs.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, o := range ol {
+		r.add(o)
+	}
+
+	return nil
+}
+
+func (r *OrganizationResolver) cached(slugOrID string) (*OrganizationDetail, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if o, ok := r.byID[slugOrID]; ok {
+		return o, true
+	}
+	o, ok := r.bySlug[slugOrID]
+	return o, ok
+}
+
+// add caches o, the caller must hold r.mu.
+func (r *OrganizationResolver) add(o *OrganizationDetail) {
+	if o.ID != "" {
+		r.byID[o.ID] = o
+	}
+	if o.Slug != "" {
+		r.bySlug[o.Slug] = o
+	}
+}
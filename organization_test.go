@@ -0,0 +1,113 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_organizations_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgSlug := "gh/org1"
+
+	mux.HandleFunc(fmt.Sprintf("/organization/%s", orgSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"id": "1", "name": "org1", "slug": "gh/org1", "vcs_type": "github"}`)
+	})
+
+	ctx := context.Background()
+	o, err := client.Organizations.Get(ctx, orgSlug)
+	if err != nil {
+		t.Errorf("Organizations.Get got error: %v", err)
+	}
+
+	want := &OrganizationDetail{
+		ID:      "1",
+		Name:    "org1",
+		Slug:    orgSlug,
+		VcsType: "github",
+	}
+
+	if !cmp.Equal(o, want) {
+		t.Errorf("Organizations.Get got %+v, want %+v", o, want)
+	}
+}
+
+func Test_organizations_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/collaborations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": "1", "vcs-type": "github", "name": "org1", "slug": "gh/org1", "avatar_url": "avatar1"}]`)
+	})
+
+	ctx := context.Background()
+	os, err := client.Organizations.List(ctx)
+	if err != nil {
+		t.Errorf("Organizations.List got error: %v", err)
+	}
+
+	want := []*OrganizationDetail{
+		{
+			ID:      "1",
+			Name:    "org1",
+			Slug:    "gh/org1",
+			VcsType: "github",
+		},
+	}
+
+	if !cmp.Equal(os, want) {
+		t.Errorf("Organizations.List got %+v, want %+v", os, want)
+	}
+}
+
+func Test_OrganizationResolver(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "5b3a2e4c-1a2b-4c3d-8e9f-0a1b2c3d4e5f"
+	orgSlug := "gh/org1"
+
+	var calls int
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprintf(w, `{"id": "%s", "name": "org1", "slug": "%s", "vcs_type": "github"}`, orgID, orgSlug)
+	}
+	mux.HandleFunc(fmt.Sprintf("/organization/%s", orgSlug), handler)
+	mux.HandleFunc(fmt.Sprintf("/organization/%s", orgID), handler)
+
+	ctx := context.Background()
+	r := NewOrganizationResolver(client.Organizations)
+
+	id, err := r.ID(ctx, orgSlug)
+	if err != nil {
+		t.Errorf("OrganizationResolver.ID got error: %v", err)
+	}
+	if id != orgID {
+		t.Errorf("OrganizationResolver.ID got %s, want %s", id, orgID)
+	}
+
+	slug, err := r.Slug(ctx, orgID)
+	if err != nil {
+		t.Errorf("OrganizationResolver.Slug got error: %v", err)
+	}
+	if slug != orgSlug {
+		t.Errorf("OrganizationResolver.Slug got %s, want %s", slug, orgSlug)
+	}
+
+	if calls != 1 {
+		t.Errorf("OrganizationResolver called the API %d times, want 1", calls)
+	}
+
+	if _, err := r.ID(ctx, ""); err != ErrRequiredEitherOrganizationIDOrSlug {
+		t.Errorf("OrganizationResolver.ID got error %v, want %v", err, ErrRequiredEitherOrganizationIDOrSlug)
+	}
+}
@@ -0,0 +1,227 @@
+package circleci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/grezar/go-circleci/config"
+	"gopkg.in/yaml.v3"
+)
+
+// ParameterSchema holds the pipeline parameters declared by a config, keyed
+// by name. Use it to check parameters before passing them to
+// Projects.TriggerPipeline or Pipelines.Continue.
+type ParameterSchema map[string]*config.Parameter
+
+// ParseParameterSchema reads the parameter declarations of the config YAML.
+// Only the top-level parameters key is decoded, so the rest of the config
+// doesn't need to be understood.
+func ParseParameterSchema(configYAML []byte) (ParameterSchema, error) {
+	var c struct {
+		Parameters map[string]*config.Parameter `yaml:"parameters"`
+	}
+	if err := yaml.Unmarshal(configYAML, &c); err != nil {
+		return nil, err
+	}
+
+	s := ParameterSchema{}
+	for name, p := range c.Parameters {
+		s[name] = p
+	}
+	return s, nil
+}
+
+// GetParameterSchema reads the parameter declarations from the config of
+// the latest pipeline of the project, optionally restricted to a branch.
+func (c *Client) GetParameterSchema(ctx context.Context, projectSlug string, branch *string) (ParameterSchema, error) {
+	pl, err := c.Projects.ListPipelines(ctx, projectSlug, ProjectListPipelinesOptions{
+		Branch: branch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pl.Items) == 0 {
+		return nil, ErrNoProjectPipelines
+	}
+
+	pc, err := c.Pipelines.GetConfig(ctx, pl.Items[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parameters are declared in the source, the compiled config has them
+	// already substituted.
+	return ParseParameterSchema([]byte(pc.Source))
+}
+
+type ParameterError struct {
+	Name    string
+	Message string
+}
+
+func (e *ParameterError) Error() string {
+	return fmt.Sprintf("parameter %q: %s", e.Name, e.Message)
+}
+
+// ValidationError lists every parameter rejected by ParameterSchema.Validate.
+type ValidationError struct {
+	Errors []*ParameterError
+	// Values are the parameters the pipeline would run with, that is the
+	// given parameters with declared defaults filled in.
+	Values map[string]interface{}
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return "invalid pipeline parameters: " + strings.Join(msgs, "; ")
+}
+
+// Validate checks params against the schema. It returns the parameters with
+// declared defaults filled in, and a *ValidationError when any parameter is
+// unknown, of the wrong type, not one of its enum values, or required and
+// missing.
+func (s ParameterSchema) Validate(params map[string]interface{}) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	var errs []*ParameterError
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := params[name]
+		p, ok := s[name]
+		if !ok {
+			msg := "is not declared"
+			if suggestion := s.closest(name); suggestion != "" {
+				msg += fmt.Sprintf(", did you mean %q?", suggestion)
+			}
+			errs = append(errs, &ParameterError{Name: name, Message: msg})
+			continue
+		}
+
+		if msg := checkParameterValue(p, v); msg != "" {
+			errs = append(errs, &ParameterError{Name: name, Message: msg})
+			continue
+		}
+		values[name] = v
+	}
+
+	declared := make([]string, 0, len(s))
+	for name := range s {
+		declared = append(declared, name)
+	}
+	sort.Strings(declared)
+
+	for _, name := range declared {
+		if _, ok := params[name]; ok {
+			continue
+		}
+		if s[name].Default == nil {
+			errs = append(errs, &ParameterError{Name: name, Message: "is required"})
+			continue
+		}
+		values[name] = s[name].Default
+	}
+
+	if len(errs) > 0 {
+		return values, &ValidationError{Errors: errs, Values: values}
+	}
+
+	return values, nil
+}
+
+// closest returns the declared parameter name closest to name, if it is
+// close enough to be a typo.
+func (s ParameterSchema) closest(name string) string {
+	best, bestDist := "", 3
+	for declared := range s {
+		if d := editDistance(name, declared); d < bestDist || (d == bestDist && declared < best) {
+			best, bestDist = declared, d
+		}
+	}
+	return best
+}
+
+func checkParameterValue(p *config.Parameter, v interface{}) string {
+	switch p.Type {
+	case "string":
+		if _, ok := v.(string); !ok {
+			return fmt.Sprintf("expected a string, got %T", v)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Sprintf("expected a boolean, got %T", v)
+		}
+	case "integer":
+		if !isInteger(v) {
+			return fmt.Sprintf("expected an integer, got %T", v)
+		}
+	case "enum":
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Sprintf("expected a string, got %T", v)
+		}
+		for _, e := range p.Enum {
+			if s == e {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%q is not one of %s", s, strings.Join(p.Enum, ", "))
+	default:
+		return fmt.Sprintf("type %q can't be set as a pipeline parameter", p.Type)
+	}
+	return ""
+}
+
+func isInteger(v interface{}) bool {
+	switch v := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return true
+	case float64:
+		// Numbers decoded from JSON are float64.
+		return v == math.Trunc(v)
+	case json.Number:
+		_, err := v.Int64()
+		return err == nil
+	}
+	return false
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d := prev[j-1] + cost
+			if prev[j]+1 < d {
+				d = prev[j] + 1
+			}
+			if cur[j-1]+1 < d {
+				d = cur[j-1] + 1
+			}
+			cur[j] = d
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,136 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const parameterConfig = `version: 2.1
+parameters:
+  deploy:
+    type: boolean
+    default: false
+  environment:
+    type: enum
+    enum: [staging, production]
+    default: staging
+  replicas:
+    type: integer
+  image-tag:
+    type: string
+    default: latest
+jobs:
+  build:
+    docker:
+      - image: cimg/base:stable
+    parallelism: << pipeline.parameters.replicas >>
+    steps:
+      - checkout
+      - run:
+          command: ./deploy.sh
+          background: << pipeline.parameters.deploy >>
+`
+
+func Test_ParameterSchema_Validate(t *testing.T) {
+	s, err := ParseParameterSchema([]byte(parameterConfig))
+	if err != nil {
+		t.Fatalf("ParseParameterSchema got error: %v", err)
+	}
+
+	values, err := s.Validate(map[string]interface{}{
+		"deploy":   true,
+		"replicas": float64(3),
+	})
+	if err != nil {
+		t.Errorf("ParameterSchema.Validate got error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"deploy":      true,
+		"environment": "staging",
+		"replicas":    float64(3),
+		"image-tag":   "latest",
+	}
+
+	if !cmp.Equal(values, want) {
+		t.Errorf("ParameterSchema.Validate got %+v, want %+v", values, want)
+	}
+}
+
+func Test_ParameterSchema_Validate_errors(t *testing.T) {
+	s, err := ParseParameterSchema([]byte(parameterConfig))
+	if err != nil {
+		t.Fatalf("ParseParameterSchema got error: %v", err)
+	}
+
+	_, err = s.Validate(map[string]interface{}{
+		"deploy":      "yes",
+		"enviroment":  "production",
+		"environment": "qa",
+		"replicas":    1.5,
+	})
+
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("ParameterSchema.Validate got error %v, want *ValidationError", err)
+	}
+
+	want := []*ParameterError{
+		{Name: "deploy", Message: "expected a boolean, got string"},
+		{Name: "enviroment", Message: `is not declared, did you mean "environment"?`},
+		{Name: "environment", Message: `"qa" is not one of staging, production`},
+		{Name: "replicas", Message: "expected an integer, got float64"},
+	}
+
+	if !cmp.Equal(verr.Errors, want) {
+		t.Errorf("ValidationError.Errors got diff (-got +want):\n%s", cmp.Diff(verr.Errors, want))
+	}
+
+	_, err = s.Validate(nil)
+	if verr, ok := err.(*ValidationError); !ok || len(verr.Errors) != 1 || verr.Errors[0].Name != "replicas" {
+		t.Errorf("ParameterSchema.Validate got error %v, want replicas to be required", err)
+	}
+}
+
+func Test_ParseParameterSchema(t *testing.T) {
+	// Only the parameters are read, whatever the rest of the config holds.
+	s, err := ParseParameterSchema([]byte(parameterConfig + "workflows: << pipeline.parameters.image-tag >>\n"))
+	if err != nil {
+		t.Fatalf("ParseParameterSchema got error: %v", err)
+	}
+
+	if len(s) != 4 || s["replicas"].Type != "integer" {
+		t.Errorf("ParseParameterSchema got %+v", s)
+	}
+}
+
+func Test_Client_GetParameterSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/project/%s/pipeline", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "branch", "main")
+		fmt.Fprint(w, `{"items": [{"id": "pipeline2"}, {"id": "pipeline1"}]}`)
+	})
+	mux.HandleFunc("/pipeline/pipeline2/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"source": %q, "compiled": "version: 2\n"}`, parameterConfig)
+	})
+
+	ctx := context.Background()
+	s, err := client.GetParameterSchema(ctx, projectSlug, String("main"))
+	if err != nil {
+		t.Fatalf("Client.GetParameterSchema got error: %v", err)
+	}
+
+	if len(s) != 4 || s["environment"].Type != "enum" {
+		t.Errorf("Client.GetParameterSchema got %+v", s)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/grezar/go-circleci/config"
 )
 
 type Pipelines interface {
@@ -158,6 +160,20 @@ type PipelineConfig struct {
 	CompiledSetupConfig string `json:"compiled-setup-config"`
 }
 
+// Parse parses the compiled config.
+func (c *PipelineConfig) Parse() (*config.Config, error) {
+	return config.Parse([]byte(c.Compiled))
+}
+
+// ParseSetup parses the compiled setup config. It returns nil when the
+// pipeline was not started from a setup workflow.
+func (c *PipelineConfig) ParseSetup() (*config.Config, error) {
+	if c.CompiledSetupConfig == "" {
+		return nil, nil
+	}
+	return config.Parse([]byte(c.CompiledSetupConfig))
+}
+
 func (s *pipelines) GetConfig(ctx context.Context, pipelineID string) (*PipelineConfig, error) {
 	if !validString(&pipelineID) {
 		return nil, ErrRequiredPipelinePipelineID
@@ -0,0 +1,390 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PipelineDefinitions manages the pipeline definitions and triggers of
+// projects integrated through the GitHub App or created as standalone
+// projects. Unlike most services, they are addressed by project ID rather
+// than project slug.
+type PipelineDefinitions interface {
+	List(ctx context.Context, projectID string) (*PipelineDefinitionList, error)
+	Get(ctx context.Context, projectID, definitionID string) (*PipelineDefinition, error)
+	Create(ctx context.Context, projectID string, options PipelineDefinitionCreateOptions) (*PipelineDefinition, error)
+	Update(ctx context.Context, projectID, definitionID string, options PipelineDefinitionUpdateOptions) (*PipelineDefinition, error)
+	Delete(ctx context.Context, projectID, definitionID string) error
+	ListTriggers(ctx context.Context, projectID, definitionID string) (*PipelineTriggerList, error)
+	GetTrigger(ctx context.Context, projectID, triggerID string) (*PipelineTrigger, error)
+	CreateTrigger(ctx context.Context, projectID, definitionID string, options PipelineTriggerCreateOptions) (*PipelineTrigger, error)
+	UpdateTrigger(ctx context.Context, projectID, triggerID string, options PipelineTriggerUpdateOptions) (*PipelineTrigger, error)
+	DeleteTrigger(ctx context.Context, projectID, triggerID string) error
+}
+
+// pipelineDefinitions implements PipelineDefinitions interface
+type pipelineDefinitions struct {
+	client *Client
+}
+
+type PipelineDefinitionList struct {
+	Items []*PipelineDefinition `json:"items"`
+}
+
+type PipelineDefinition struct {
+	ID             string                  `json:"id"`
+	Name           string                  `json:"name"`
+	Description    string                  `json:"description"`
+	CreatedAt      time.Time               `json:"created_at"`
+	ConfigSource   *PipelineConfigSource   `json:"config_source"`
+	CheckoutSource *PipelineCheckoutSource `json:"checkout_source"`
+}
+
+type PipelineConfigSource struct {
+	Provider string        `json:"provider"`
+	Repo     *PipelineRepo `json:"repo"`
+	FilePath string        `json:"file_path"`
+}
+
+type PipelineCheckoutSource struct {
+	Provider string        `json:"provider"`
+	Repo     *PipelineRepo `json:"repo"`
+}
+
+type PipelineRepo struct {
+	ExternalID string `json:"external_id"`
+	FullName   string `json:"full_name,omitempty"`
+}
+
+func (s *pipelineDefinitions) List(ctx context.Context, projectID string) (*PipelineDefinitionList, error) {
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	u := fmt.Sprintf("projects/%s/pipeline-definitions", projectID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pdl := &PipelineDefinitionList{}
+	err = s.client.do(ctx, req, pdl)
+	if err != nil {
+		return nil, err
+	}
+
+	return pdl, nil
+}
+
+func (s *pipelineDefinitions) Get(ctx context.Context, projectID, definitionID string) (*PipelineDefinition, error) {
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	if !validString(&definitionID) {
+		return nil, ErrRequiredPipelineDefinitionID
+	}
+
+	u := fmt.Sprintf("projects/%s/pipeline-definitions/%s", projectID, definitionID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &PipelineDefinition{}
+	err = s.client.do(ctx, req, pd)
+	if err != nil {
+		return nil, err
+	}
+
+	return pd, nil
+}
+
+type PipelineDefinitionCreateOptions struct {
+	Name           *string                 `json:"name"`
+	Description    *string                 `json:"description,omitempty"`
+	ConfigSource   *PipelineConfigSource   `json:"config_source"`
+	CheckoutSource *PipelineCheckoutSource `json:"checkout_source"`
+}
+
+func (o PipelineDefinitionCreateOptions) valid() error {
+	if !validString(o.Name) {
+		return ErrRequiredPipelineDefinitionName
+	}
+
+	if o.ConfigSource == nil {
+		return ErrRequiredPipelineDefinitionConfigSource
+	}
+
+	if o.CheckoutSource == nil {
+		return ErrRequiredPipelineDefinitionCheckoutSource
+	}
+
+	return nil
+}
+
+func (s *pipelineDefinitions) Create(ctx context.Context, projectID string, options PipelineDefinitionCreateOptions) (*PipelineDefinition, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	u := fmt.Sprintf("projects/%s/pipeline-definitions", projectID)
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &PipelineDefinition{}
+	err = s.client.do(ctx, req, pd)
+	if err != nil {
+		return nil, err
+	}
+
+	return pd, nil
+}
+
+type PipelineDefinitionUpdateOptions struct {
+	Name           *string                 `json:"name,omitempty"`
+	Description    *string                 `json:"description,omitempty"`
+	ConfigSource   *PipelineConfigSource   `json:"config_source,omitempty"`
+	CheckoutSource *PipelineCheckoutSource `json:"checkout_source,omitempty"`
+}
+
+func (o PipelineDefinitionUpdateOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+func (s *pipelineDefinitions) Update(ctx context.Context, projectID, definitionID string, options PipelineDefinitionUpdateOptions) (*PipelineDefinition, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	if !validString(&definitionID) {
+		return nil, ErrRequiredPipelineDefinitionID
+	}
+
+	u := fmt.Sprintf("projects/%s/pipeline-definitions/%s", projectID, definitionID)
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &PipelineDefinition{}
+	err = s.client.do(ctx, req, pd)
+	if err != nil {
+		return nil, err
+	}
+
+	return pd, nil
+}
+
+func (s *pipelineDefinitions) Delete(ctx context.Context, projectID, definitionID string) error {
+	if !validString(&projectID) {
+		return ErrRequiredProjectID
+	}
+
+	if !validString(&definitionID) {
+		return ErrRequiredPipelineDefinitionID
+	}
+
+	u := fmt.Sprintf("projects/%s/pipeline-definitions/%s", projectID, definitionID)
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+type PipelineTriggerList struct {
+	Items []*PipelineTrigger `json:"items"`
+}
+
+type PipelineTrigger struct {
+	ID          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	EventSource *PipelineTriggerEventSource `json:"event_source"`
+	EventPreset string                      `json:"event_preset"`
+	EventName   string                      `json:"event_name"`
+	CheckoutRef string                      `json:"checkout_ref"`
+	ConfigRef   string                      `json:"config_ref"`
+	Disabled    bool                        `json:"disabled"`
+}
+
+type PipelineTriggerEventSource struct {
+	Provider string                  `json:"provider"`
+	Repo     *PipelineRepo           `json:"repo,omitempty"`
+	Webhook  *PipelineTriggerWebhook `json:"webhook,omitempty"`
+}
+
+type PipelineTriggerWebhook struct {
+	URL    string `json:"url,omitempty"`
+	Sender string `json:"sender,omitempty"`
+}
+
+func (s *pipelineDefinitions) ListTriggers(ctx context.Context, projectID, definitionID string) (*PipelineTriggerList, error) {
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	if !validString(&definitionID) {
+		return nil, ErrRequiredPipelineDefinitionID
+	}
+
+	u := fmt.Sprintf("projects/%s/pipeline-definitions/%s/triggers", projectID, definitionID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ptl := &PipelineTriggerList{}
+	err = s.client.do(ctx, req, ptl)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptl, nil
+}
+
+func (s *pipelineDefinitions) GetTrigger(ctx context.Context, projectID, triggerID string) (*PipelineTrigger, error) {
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	if !validString(&triggerID) {
+		return nil, ErrRequiredPipelineTriggerID
+	}
+
+	u := fmt.Sprintf("projects/%s/triggers/%s", projectID, triggerID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pt := &PipelineTrigger{}
+	err = s.client.do(ctx, req, pt)
+	if err != nil {
+		return nil, err
+	}
+
+	return pt, nil
+}
+
+type PipelineTriggerCreateOptions struct {
+	Name        *string                     `json:"name,omitempty"`
+	Description *string                     `json:"description,omitempty"`
+	EventSource *PipelineTriggerEventSource `json:"event_source"`
+	EventPreset *string                     `json:"event_preset,omitempty"`
+	CheckoutRef *string                     `json:"checkout_ref,omitempty"`
+	ConfigRef   *string                     `json:"config_ref,omitempty"`
+	Disabled    *bool                       `json:"disabled,omitempty"`
+}
+
+func (o PipelineTriggerCreateOptions) valid() error {
+	if o.EventSource == nil {
+		return ErrRequiredPipelineTriggerEventSource
+	}
+
+	return nil
+}
+
+func (s *pipelineDefinitions) CreateTrigger(ctx context.Context, projectID, definitionID string, options PipelineTriggerCreateOptions) (*PipelineTrigger, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	if !validString(&definitionID) {
+		return nil, ErrRequiredPipelineDefinitionID
+	}
+
+	u := fmt.Sprintf("projects/%s/pipeline-definitions/%s/triggers", projectID, definitionID)
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pt := &PipelineTrigger{}
+	err = s.client.do(ctx, req, pt)
+	if err != nil {
+		return nil, err
+	}
+
+	return pt, nil
+}
+
+type PipelineTriggerUpdateOptions struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	EventPreset *string `json:"event_preset,omitempty"`
+	CheckoutRef *string `json:"checkout_ref,omitempty"`
+	ConfigRef   *string `json:"config_ref,omitempty"`
+	Disabled    *bool   `json:"disabled,omitempty"`
+}
+
+func (o PipelineTriggerUpdateOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+func (s *pipelineDefinitions) UpdateTrigger(ctx context.Context, projectID, triggerID string, options PipelineTriggerUpdateOptions) (*PipelineTrigger, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectID) {
+		return nil, ErrRequiredProjectID
+	}
+
+	if !validString(&triggerID) {
+		return nil, ErrRequiredPipelineTriggerID
+	}
+
+	u := fmt.Sprintf("projects/%s/triggers/%s", projectID, triggerID)
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pt := &PipelineTrigger{}
+	err = s.client.do(ctx, req, pt)
+	if err != nil {
+		return nil, err
+	}
+
+	return pt, nil
+}
+
+func (s *pipelineDefinitions) DeleteTrigger(ctx context.Context, projectID, triggerID string) error {
+	if !validString(&projectID) {
+		return ErrRequiredProjectID
+	}
+
+	if !validString(&triggerID) {
+		return ErrRequiredPipelineTriggerID
+	}
+
+	u := fmt.Sprintf("projects/%s/triggers/%s", projectID, triggerID)
+	req, err := s.client.newRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
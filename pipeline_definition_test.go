@@ -0,0 +1,328 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_pipelineDefinitions_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/pipeline-definitions", projectID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"items": [{"id": "1", "name": "definition1"}]}`)
+	})
+
+	ctx := context.Background()
+	pdl, err := client.PipelineDefinitions.List(ctx, projectID)
+	if err != nil {
+		t.Errorf("PipelineDefinitions.List got error: %v", err)
+	}
+
+	want := &PipelineDefinitionList{
+		Items: []*PipelineDefinition{
+			{
+				ID:   "1",
+				Name: "definition1",
+			},
+		},
+	}
+
+	if !cmp.Equal(pdl, want) {
+		t.Errorf("PipelineDefinitions.List got %+v, want %+v", pdl, want)
+	}
+}
+
+func Test_pipelineDefinitions_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	definitionID := "def1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/pipeline-definitions/%s", projectID, definitionID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"id": "def1", "config_source": {"provider": "github_app", "repo": {"external_id": "123"}, "file_path": ".circleci/config.yml"}}`)
+	})
+
+	ctx := context.Background()
+	pd, err := client.PipelineDefinitions.Get(ctx, projectID, definitionID)
+	if err != nil {
+		t.Errorf("PipelineDefinitions.Get got error: %v", err)
+	}
+
+	want := &PipelineDefinition{
+		ID: definitionID,
+		ConfigSource: &PipelineConfigSource{
+			Provider: "github_app",
+			Repo:     &PipelineRepo{ExternalID: "123"},
+			FilePath: ".circleci/config.yml",
+		},
+	}
+
+	if !cmp.Equal(pd, want) {
+		t.Errorf("PipelineDefinitions.Get got %+v, want %+v", pd, want)
+	}
+}
+
+func Test_pipelineDefinitions_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/pipeline-definitions", projectID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"name":"definition1","config_source":{"provider":"github_app","repo":{"external_id":"123"},"file_path":".circleci/config.yml"},"checkout_source":{"provider":"github_app","repo":{"external_id":"123"}}}`+"\n")
+		fmt.Fprint(w, `{"id": "def1", "name": "definition1"}`)
+	})
+
+	ctx := context.Background()
+	pd, err := client.PipelineDefinitions.Create(ctx, projectID, PipelineDefinitionCreateOptions{
+		Name: String("definition1"),
+		ConfigSource: &PipelineConfigSource{
+			Provider: "github_app",
+			Repo:     &PipelineRepo{ExternalID: "123"},
+			FilePath: ".circleci/config.yml",
+		},
+		CheckoutSource: &PipelineCheckoutSource{
+			Provider: "github_app",
+			Repo:     &PipelineRepo{ExternalID: "123"},
+		},
+	})
+	if err != nil {
+		t.Errorf("PipelineDefinitions.Create got error: %v", err)
+	}
+
+	want := &PipelineDefinition{
+		ID:   "def1",
+		Name: "definition1",
+	}
+
+	if !cmp.Equal(pd, want) {
+		t.Errorf("PipelineDefinitions.Create got %+v, want %+v", pd, want)
+	}
+}
+
+func Test_pipelineDefinitions_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	definitionID := "def1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/pipeline-definitions/%s", projectID, definitionID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"description":"desc1"}`+"\n")
+		fmt.Fprint(w, `{"id": "def1", "description": "desc1"}`)
+	})
+
+	ctx := context.Background()
+	pd, err := client.PipelineDefinitions.Update(ctx, projectID, definitionID, PipelineDefinitionUpdateOptions{
+		Description: String("desc1"),
+	})
+	if err != nil {
+		t.Errorf("PipelineDefinitions.Update got error: %v", err)
+	}
+
+	want := &PipelineDefinition{
+		ID:          definitionID,
+		Description: "desc1",
+	}
+
+	if !cmp.Equal(pd, want) {
+		t.Errorf("PipelineDefinitions.Update got %+v, want %+v", pd, want)
+	}
+}
+
+func Test_pipelineDefinitions_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	definitionID := "def1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/pipeline-definitions/%s", projectID, definitionID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+	})
+
+	ctx := context.Background()
+	err := client.PipelineDefinitions.Delete(ctx, projectID, definitionID)
+	if err != nil {
+		t.Errorf("PipelineDefinitions.Delete got error: %v", err)
+	}
+}
+
+func Test_pipelineDefinitions_ListTriggers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	definitionID := "def1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/pipeline-definitions/%s/triggers", projectID, definitionID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"items": [{"id": "1", "event_preset": "all-pushes"}]}`)
+	})
+
+	ctx := context.Background()
+	ptl, err := client.PipelineDefinitions.ListTriggers(ctx, projectID, definitionID)
+	if err != nil {
+		t.Errorf("PipelineDefinitions.ListTriggers got error: %v", err)
+	}
+
+	want := &PipelineTriggerList{
+		Items: []*PipelineTrigger{
+			{
+				ID:          "1",
+				EventPreset: "all-pushes",
+			},
+		},
+	}
+
+	if !cmp.Equal(ptl, want) {
+		t.Errorf("PipelineDefinitions.ListTriggers got %+v, want %+v", ptl, want)
+	}
+}
+
+func Test_pipelineDefinitions_GetTrigger(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	triggerID := "trigger1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/triggers/%s", projectID, triggerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"id": "trigger1", "event_source": {"provider": "webhook", "webhook": {"url": "https://example.com"}}}`)
+	})
+
+	ctx := context.Background()
+	pt, err := client.PipelineDefinitions.GetTrigger(ctx, projectID, triggerID)
+	if err != nil {
+		t.Errorf("PipelineDefinitions.GetTrigger got error: %v", err)
+	}
+
+	want := &PipelineTrigger{
+		ID: triggerID,
+		EventSource: &PipelineTriggerEventSource{
+			Provider: "webhook",
+			Webhook:  &PipelineTriggerWebhook{URL: "https://example.com"},
+		},
+	}
+
+	if !cmp.Equal(pt, want) {
+		t.Errorf("PipelineDefinitions.GetTrigger got %+v, want %+v", pt, want)
+	}
+}
+
+func Test_pipelineDefinitions_CreateTrigger(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	definitionID := "def1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/pipeline-definitions/%s/triggers", projectID, definitionID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"event_source":{"provider":"github_app","repo":{"external_id":"123"}},"event_preset":"all-pushes"}`+"\n")
+		fmt.Fprint(w, `{"id": "trigger1", "event_preset": "all-pushes"}`)
+	})
+
+	ctx := context.Background()
+	pt, err := client.PipelineDefinitions.CreateTrigger(ctx, projectID, definitionID, PipelineTriggerCreateOptions{
+		EventSource: &PipelineTriggerEventSource{
+			Provider: "github_app",
+			Repo:     &PipelineRepo{ExternalID: "123"},
+		},
+		EventPreset: String("all-pushes"),
+	})
+	if err != nil {
+		t.Errorf("PipelineDefinitions.CreateTrigger got error: %v", err)
+	}
+
+	want := &PipelineTrigger{
+		ID:          "trigger1",
+		EventPreset: "all-pushes",
+	}
+
+	if !cmp.Equal(pt, want) {
+		t.Errorf("PipelineDefinitions.CreateTrigger got %+v, want %+v", pt, want)
+	}
+}
+
+func Test_pipelineDefinitions_UpdateTrigger(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	triggerID := "trigger1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/triggers/%s", projectID, triggerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"disabled":true}`+"\n")
+		fmt.Fprint(w, `{"id": "trigger1", "disabled": true}`)
+	})
+
+	ctx := context.Background()
+	pt, err := client.PipelineDefinitions.UpdateTrigger(ctx, projectID, triggerID, PipelineTriggerUpdateOptions{
+		Disabled: Bool(true),
+	})
+	if err != nil {
+		t.Errorf("PipelineDefinitions.UpdateTrigger got error: %v", err)
+	}
+
+	want := &PipelineTrigger{
+		ID:       triggerID,
+		Disabled: true,
+	}
+
+	if !cmp.Equal(pt, want) {
+		t.Errorf("PipelineDefinitions.UpdateTrigger got %+v, want %+v", pt, want)
+	}
+}
+
+func Test_pipelineDefinitions_DeleteTrigger(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectID := "prj1"
+	triggerID := "trigger1"
+
+	mux.HandleFunc(fmt.Sprintf("/projects/%s/triggers/%s", projectID, triggerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+	})
+
+	ctx := context.Background()
+	err := client.PipelineDefinitions.DeleteTrigger(ctx, projectID, triggerID)
+	if err != nil {
+		t.Errorf("PipelineDefinitions.DeleteTrigger got error: %v", err)
+	}
+}
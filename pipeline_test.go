@@ -130,6 +130,26 @@ func Test_pipelines_GetConfig(t *testing.T) {
 	}
 }
 
+func Test_PipelineConfig_Parse(t *testing.T) {
+	pc := &PipelineConfig{
+		Compiled: "version: 2\njobs:\n  build:\n    docker:\n      - image: cimg/base:stable\n    steps:\n      - checkout\n",
+	}
+
+	c, err := pc.Parse()
+	if err != nil {
+		t.Errorf("PipelineConfig.Parse got error: %v", err)
+	}
+
+	if got, want := c.Jobs["build"].Docker[0].Image, "cimg/base:stable"; got != want {
+		t.Errorf("PipelineConfig.Parse got image %q, want %q", got, want)
+	}
+
+	c, err = pc.ParseSetup()
+	if err != nil || c != nil {
+		t.Errorf("PipelineConfig.ParseSetup got %+v, %v, want nil", c, err)
+	}
+}
+
 func Test_pipelines_ListWorkflows(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
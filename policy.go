@@ -0,0 +1,300 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+type Policies interface {
+	GetBundle(ctx context.Context, ownerID string) (PolicyBundle, error)
+	CreateBundle(ctx context.Context, ownerID string, options PolicyCreateBundleOptions) (*PolicyBundleDiff, error)
+	ListDecisions(ctx context.Context, ownerID string, options PolicyListDecisionsOptions) ([]*PolicyDecisionLog, error)
+	GetDecision(ctx context.Context, ownerID, decisionID string) (*PolicyDecisionLog, error)
+	GetDecisionBundle(ctx context.Context, ownerID, decisionID string) (PolicyBundle, error)
+	GetDecisionSettings(ctx context.Context, ownerID string) (*PolicyDecisionSettings, error)
+	UpdateDecisionSettings(ctx context.Context, ownerID string, options PolicyUpdateDecisionSettingsOptions) (*PolicyDecisionSettings, error)
+}
+
+// policies implements Policies interface
+type policies struct {
+	client *Client
+}
+
+// policyPath returns the path of the given config policy endpoint. Config
+// policies are served by the v1 API rather than the v2 one.
+func policyPath(ownerID, path string) string {
+	return fmt.Sprintf("../v1/owner/%s/context/config/%s", ownerID, path)
+}
+
+type PolicyDecisionStatusType string
+
+const (
+	PolicyDecisionStatusPass     PolicyDecisionStatusType = "PASS"
+	PolicyDecisionStatusSoftFail PolicyDecisionStatusType = "SOFT_FAIL"
+	PolicyDecisionStatusHardFail PolicyDecisionStatusType = "HARD_FAIL"
+	PolicyDecisionStatusError    PolicyDecisionStatusType = "ERROR"
+)
+
+// PolicyBundle maps each policy name to the policy.
+type PolicyBundle map[string]*Policy
+
+type Policy struct {
+	Name      string    `json:"name"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+}
+
+func (s *policies) GetBundle(ctx context.Context, ownerID string) (PolicyBundle, error) {
+	if !validString(&ownerID) {
+		return nil, ErrRequiredOwnerID
+	}
+
+	u := policyPath(ownerID, "policy-bundle")
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := PolicyBundle{}
+	err = s.client.do(ctx, req, &pb)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+type PolicyBundleDiff struct {
+	Created  []string `json:"created"`
+	Deleted  []string `json:"deleted"`
+	Modified []string `json:"modified"`
+}
+
+type PolicyCreateBundleOptions struct {
+	// Policies maps each policy file name to its Rego source. Any policy
+	// missing from the map is deleted from the bundle.
+	Policies map[string]string `json:"policies" url:"-"`
+	DryRun   *bool             `json:"-" url:"dry,omitempty"`
+}
+
+func (o PolicyCreateBundleOptions) valid() error {
+	if o.Policies == nil {
+		return ErrRequiredPolicies
+	}
+
+	return nil
+}
+
+func (s *policies) CreateBundle(ctx context.Context, ownerID string, options PolicyCreateBundleOptions) (*PolicyBundleDiff, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&ownerID) {
+		return nil, ErrRequiredOwnerID
+	}
+
+	q, err := query.Values(options)
+	if err != nil {
+		return nil, err
+	}
+
+	u := policyPath(ownerID, "policy-bundle")
+	if len(q) != 0 {
+		u += "?" + q.Encode()
+	}
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pbd := &PolicyBundleDiff{}
+	err = s.client.do(ctx, req, pbd)
+	if err != nil {
+		return nil, err
+	}
+
+	return pbd, nil
+}
+
+type PolicyDecisionLog struct {
+	ID          string                  `json:"id"`
+	CreatedAt   time.Time               `json:"created_at"`
+	Decision    *PolicyDecision         `json:"decision"`
+	Metadata    *PolicyDecisionMetadata `json:"metadata"`
+	Policies    map[string]string       `json:"policies"`
+	TimeTakenMS int                     `json:"time_taken_ms"`
+}
+
+type PolicyDecision struct {
+	Status       PolicyDecisionStatusType `json:"status"`
+	Reason       string                   `json:"reason,omitempty"`
+	EnabledRules []string                 `json:"enabled_rules"`
+	HardFailures []*PolicyViolation       `json:"hard_failures"`
+	SoftFailures []*PolicyViolation       `json:"soft_failures"`
+}
+
+type PolicyViolation struct {
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+}
+
+type PolicyDecisionMetadata struct {
+	ProjectID   string `json:"project_id"`
+	Branch      string `json:"branch"`
+	BuildNumber int64  `json:"build_number"`
+	SSHRerun    bool   `json:"ssh_rerun"`
+}
+
+type PolicyListDecisionsOptions struct {
+	Status    *PolicyDecisionStatusType `url:"status,omitempty"`
+	After     *time.Time                `url:"after,omitempty"`
+	Before    *time.Time                `url:"before,omitempty"`
+	Branch    *string                   `url:"branch,omitempty"`
+	ProjectID *string                   `url:"project_id,omitempty"`
+	Offset    *int                      `url:"offset,omitempty"`
+}
+
+func (o PolicyListDecisionsOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+func (s *policies) ListDecisions(ctx context.Context, ownerID string, options PolicyListDecisionsOptions) ([]*PolicyDecisionLog, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&ownerID) {
+		return nil, ErrRequiredOwnerID
+	}
+
+	u := policyPath(ownerID, "decision")
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	var pdl []*PolicyDecisionLog
+	err = s.client.do(ctx, req, &pdl)
+	if err != nil {
+		return nil, err
+	}
+
+	return pdl, nil
+}
+
+func (s *policies) GetDecision(ctx context.Context, ownerID, decisionID string) (*PolicyDecisionLog, error) {
+	if !validString(&ownerID) {
+		return nil, ErrRequiredOwnerID
+	}
+
+	if !validString(&decisionID) {
+		return nil, ErrRequiredPolicyDecisionID
+	}
+
+	u := policyPath(ownerID, fmt.Sprintf("decision/%s", decisionID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pdl := &PolicyDecisionLog{}
+	err = s.client.do(ctx, req, pdl)
+	if err != nil {
+		return nil, err
+	}
+
+	return pdl, nil
+}
+
+// GetDecisionBundle returns the policy bundle that was evaluated to make the
+// given decision.
+func (s *policies) GetDecisionBundle(ctx context.Context, ownerID, decisionID string) (PolicyBundle, error) {
+	if !validString(&ownerID) {
+		return nil, ErrRequiredOwnerID
+	}
+
+	if !validString(&decisionID) {
+		return nil, ErrRequiredPolicyDecisionID
+	}
+
+	u := policyPath(ownerID, fmt.Sprintf("decision/%s/policy-bundle", decisionID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := PolicyBundle{}
+	err = s.client.do(ctx, req, &pb)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+type PolicyDecisionSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (s *policies) GetDecisionSettings(ctx context.Context, ownerID string) (*PolicyDecisionSettings, error) {
+	if !validString(&ownerID) {
+		return nil, ErrRequiredOwnerID
+	}
+
+	u := policyPath(ownerID, "decision/settings")
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pds := &PolicyDecisionSettings{}
+	err = s.client.do(ctx, req, pds)
+	if err != nil {
+		return nil, err
+	}
+
+	return pds, nil
+}
+
+type PolicyUpdateDecisionSettingsOptions struct {
+	Enabled *bool `json:"enabled"`
+}
+
+func (o PolicyUpdateDecisionSettingsOptions) valid() error {
+	if !validBool(o.Enabled) {
+		return ErrRequiredPolicyDecisionEnabled
+	}
+
+	return nil
+}
+
+func (s *policies) UpdateDecisionSettings(ctx context.Context, ownerID string, options PolicyUpdateDecisionSettingsOptions) (*PolicyDecisionSettings, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&ownerID) {
+		return nil, ErrRequiredOwnerID
+	}
+
+	u := policyPath(ownerID, "decision/settings")
+	req, err := s.client.newRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pds := &PolicyDecisionSettings{}
+	err = s.client.do(ctx, req, pds)
+	if err != nil {
+		return nil, err
+	}
+
+	return pds, nil
+}
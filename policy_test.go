@@ -0,0 +1,244 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_policies_GetBundle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ownerID := "owner1"
+
+	mux.HandleFunc(fmt.Sprintf("/v1/owner/%s/context/config/policy-bundle", ownerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"policy1": {"name": "policy1", "content": "package org"}}`)
+	})
+
+	ctx := context.Background()
+	pb, err := client.Policies.GetBundle(ctx, ownerID)
+	if err != nil {
+		t.Errorf("Policies.GetBundle got error: %v", err)
+	}
+
+	want := PolicyBundle{
+		"policy1": {
+			Name:    "policy1",
+			Content: "package org",
+		},
+	}
+
+	if !cmp.Equal(pb, want) {
+		t.Errorf("Policies.GetBundle got %+v, want %+v", pb, want)
+	}
+}
+
+func Test_policies_CreateBundle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ownerID := "owner1"
+
+	mux.HandleFunc(fmt.Sprintf("/v1/owner/%s/context/config/policy-bundle", ownerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "dry", "true")
+		testBody(t, r, `{"policies":{"policy1.rego":"package org"}}`+"\n")
+		fmt.Fprint(w, `{"created": ["policy1"]}`)
+	})
+
+	ctx := context.Background()
+	pbd, err := client.Policies.CreateBundle(ctx, ownerID, PolicyCreateBundleOptions{
+		Policies: map[string]string{"policy1.rego": "package org"},
+		DryRun:   Bool(true),
+	})
+	if err != nil {
+		t.Errorf("Policies.CreateBundle got error: %v", err)
+	}
+
+	want := &PolicyBundleDiff{
+		Created: []string{"policy1"},
+	}
+
+	if !cmp.Equal(pbd, want) {
+		t.Errorf("Policies.CreateBundle got %+v, want %+v", pbd, want)
+	}
+}
+
+func Test_policies_ListDecisions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ownerID := "owner1"
+	after := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc(fmt.Sprintf("/v1/owner/%s/context/config/decision", ownerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "status", "HARD_FAIL")
+		testQuery(t, r, "branch", "main")
+		testQuery(t, r, "project_id", "prj1")
+		testQuery(t, r, "after", "2022-01-01T00:00:00Z")
+		fmt.Fprint(w, `[{"id": "1", "decision": {"status": "HARD_FAIL"}, "metadata": {"branch": "main", "project_id": "prj1"}}]`)
+	})
+
+	ctx := context.Background()
+	pdl, err := client.Policies.ListDecisions(ctx, ownerID, PolicyListDecisionsOptions{
+		Status:    PolicyDecisionStatus(PolicyDecisionStatusHardFail),
+		After:     Time(after),
+		Branch:    String("main"),
+		ProjectID: String("prj1"),
+	})
+	if err != nil {
+		t.Errorf("Policies.ListDecisions got error: %v", err)
+	}
+
+	want := []*PolicyDecisionLog{
+		{
+			ID: "1",
+			Decision: &PolicyDecision{
+				Status: PolicyDecisionStatusHardFail,
+			},
+			Metadata: &PolicyDecisionMetadata{
+				Branch:    "main",
+				ProjectID: "prj1",
+			},
+		},
+	}
+
+	if !cmp.Equal(pdl, want) {
+		t.Errorf("Policies.ListDecisions got %+v, want %+v", pdl, want)
+	}
+}
+
+func Test_policies_GetDecision(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ownerID := "owner1"
+	decisionID := "decision1"
+
+	mux.HandleFunc(fmt.Sprintf("/v1/owner/%s/context/config/decision/%s", ownerID, decisionID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"id": "decision1", "decision": {"status": "SOFT_FAIL", "soft_failures": [{"rule": "rule1", "reason": "reason1"}]}}`)
+	})
+
+	ctx := context.Background()
+	pdl, err := client.Policies.GetDecision(ctx, ownerID, decisionID)
+	if err != nil {
+		t.Errorf("Policies.GetDecision got error: %v", err)
+	}
+
+	want := &PolicyDecisionLog{
+		ID: decisionID,
+		Decision: &PolicyDecision{
+			Status: PolicyDecisionStatusSoftFail,
+			SoftFailures: []*PolicyViolation{
+				{
+					Rule:   "rule1",
+					Reason: "reason1",
+				},
+			},
+		},
+	}
+
+	if !cmp.Equal(pdl, want) {
+		t.Errorf("Policies.GetDecision got %+v, want %+v", pdl, want)
+	}
+}
+
+func Test_policies_GetDecisionBundle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ownerID := "owner1"
+	decisionID := "decision1"
+
+	mux.HandleFunc(fmt.Sprintf("/v1/owner/%s/context/config/decision/%s/policy-bundle", ownerID, decisionID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"policy1": {"name": "policy1"}}`)
+	})
+
+	ctx := context.Background()
+	pb, err := client.Policies.GetDecisionBundle(ctx, ownerID, decisionID)
+	if err != nil {
+		t.Errorf("Policies.GetDecisionBundle got error: %v", err)
+	}
+
+	want := PolicyBundle{
+		"policy1": {Name: "policy1"},
+	}
+
+	if !cmp.Equal(pb, want) {
+		t.Errorf("Policies.GetDecisionBundle got %+v, want %+v", pb, want)
+	}
+}
+
+func Test_policies_GetDecisionSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ownerID := "owner1"
+
+	mux.HandleFunc(fmt.Sprintf("/v1/owner/%s/context/config/decision/settings", ownerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"enabled": true}`)
+	})
+
+	ctx := context.Background()
+	pds, err := client.Policies.GetDecisionSettings(ctx, ownerID)
+	if err != nil {
+		t.Errorf("Policies.GetDecisionSettings got error: %v", err)
+	}
+
+	want := &PolicyDecisionSettings{Enabled: true}
+
+	if !cmp.Equal(pds, want) {
+		t.Errorf("Policies.GetDecisionSettings got %+v, want %+v", pds, want)
+	}
+}
+
+func Test_policies_UpdateDecisionSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ownerID := "owner1"
+
+	mux.HandleFunc(fmt.Sprintf("/v1/owner/%s/context/config/decision/settings", ownerID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"enabled":false}`+"\n")
+		fmt.Fprint(w, `{"enabled": false}`)
+	})
+
+	ctx := context.Background()
+	pds, err := client.Policies.UpdateDecisionSettings(ctx, ownerID, PolicyUpdateDecisionSettingsOptions{
+		Enabled: Bool(false),
+	})
+	if err != nil {
+		t.Errorf("Policies.UpdateDecisionSettings got error: %v", err)
+	}
+
+	want := &PolicyDecisionSettings{Enabled: false}
+
+	if !cmp.Equal(pds, want) {
+		t.Errorf("Policies.UpdateDecisionSettings got %+v, want %+v", pds, want)
+	}
+}
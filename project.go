@@ -9,6 +9,9 @@ import (
 
 type Projects interface {
 	Get(ctx context.Context, projectSlug string) (*Project, error)
+	Create(ctx context.Context, provider, organization, project string) (*Project, error)
+	Follow(ctx context.Context, projectSlug string) (*ProjectFollowing, error)
+	Unfollow(ctx context.Context, projectSlug string) (*ProjectFollowing, error)
 	CreateCheckoutKey(ctx context.Context, projectSlug string, options ProjectCreateCheckoutKeyOptions) (*ProjectCheckoutKey, error)
 	ListCheckoutKeys(ctx context.Context, projectSlug string, options ProjectListCheckoutKeysOptions) (*ProjectCheckoutKeyList, error)
 	GetCheckoutKey(ctx context.Context, projectSlug, fingerprint string) (*ProjectCheckoutKey, error)
@@ -18,6 +21,7 @@ type Projects interface {
 	DeleteVariable(ctx context.Context, projectSlug, name string) error
 	GetVariable(ctx context.Context, projectSlug, name string) (*ProjectVariable, error)
 	TriggerPipeline(ctx context.Context, projectSlug string, options ProjectTriggerPipelineOptions) (*Pipeline, error)
+	RunPipeline(ctx context.Context, projectSlug string, options ProjectRunPipelineOptions) (*Pipeline, error)
 	ListPipelines(ctx context.Context, projectSlug string, options ProjectListPipelinesOptions) (*PipelineList, error)
 	ListMyPipelines(ctx context.Context, projectSlug string, options ProjectListMyPipelinesOptions) (*PipelineList, error)
 	GetPipeline(ctx context.Context, projectSlug string, pipelineNumber string) (*Pipeline, error)
@@ -69,6 +73,69 @@ func (s *projects) Get(ctx context.Context, projectSlug string) (*Project, error
 	return p, nil
 }
 
+func (s *projects) Create(ctx context.Context, provider, organization, project string) (*Project, error) {
+	if !validString(&provider) {
+		return nil, ErrRequiredProjectProvider
+	}
+
+	if !validString(&organization) {
+		return nil, ErrRequiredProjectOrganization
+	}
+
+	if !validString(&project) {
+		return nil, ErrRequiredProjectName
+	}
+
+	u := fmt.Sprintf("project/%s/%s/%s", provider, organization, project)
+	req, err := s.client.newRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{}
+	err = s.client.do(ctx, req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ProjectFollowing is the result of following or unfollowing a project.
+// Following is only supported by the v1.1 API.
+type ProjectFollowing struct {
+	Following bool `json:"following"`
+	Workflow  bool `json:"workflow"`
+}
+
+func (s *projects) Follow(ctx context.Context, projectSlug string) (*ProjectFollowing, error) {
+	return s.follow(ctx, projectSlug, "follow")
+}
+
+func (s *projects) Unfollow(ctx context.Context, projectSlug string) (*ProjectFollowing, error) {
+	return s.follow(ctx, projectSlug, "unfollow")
+}
+
+func (s *projects) follow(ctx context.Context, projectSlug, action string) (*ProjectFollowing, error) {
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	u := v1Path(fmt.Sprintf("project/%s/%s", v1ProjectSlug(projectSlug), action))
+	req, err := s.client.newRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pf := &ProjectFollowing{}
+	err = s.client.do(ctx, req, pf)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf, nil
+}
+
 type ProjectCheckoutKey struct {
 	// seems like public documentation says public-key should be the key but
 	// actually returned one is public_key
@@ -340,6 +407,51 @@ func (s *projects) TriggerPipeline(ctx context.Context, projectSlug string, opti
 	return p, nil
 }
 
+// PipelineRef points at either a branch or a tag.
+type PipelineRef struct {
+	Branch *string `json:"branch,omitempty"`
+	Tag    *string `json:"tag,omitempty"`
+}
+
+type ProjectRunPipelineOptions struct {
+	DefinitionID *string                `json:"definition_id,omitempty"`
+	Config       *PipelineRef           `json:"config,omitempty"`
+	Checkout     *PipelineRef           `json:"checkout,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+}
+
+func (o ProjectRunPipelineOptions) valid() error {
+	// Nothing is required. The default pipeline definition is run on the
+	// default branch unless told otherwise.
+	return nil
+}
+
+// RunPipeline runs a pipeline definition of a project. Unlike
+// TriggerPipeline, it supports projects integrated through the GitHub App.
+func (s *projects) RunPipeline(ctx context.Context, projectSlug string, options ProjectRunPipelineOptions) (*Pipeline, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	u := fmt.Sprintf("project/%s/pipeline/run", projectSlug)
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Pipeline{}
+	err = s.client.do(ctx, req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 type ProjectListPipelinesOptions struct {
 	Branch    *string `url:"branch,omitempty"`
 	PageToken *string `url:"page-token,omitempty"`
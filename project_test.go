@@ -37,6 +37,88 @@ func Test_projects_Get(t *testing.T) {
 	}
 }
 
+func Test_projects_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/project/gh/org1/prj1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"id": "1", "slug": "gh/org1/prj1"}`)
+	})
+
+	ctx := context.Background()
+	p, err := client.Projects.Create(ctx, "gh", "org1", "prj1")
+	if err != nil {
+		t.Errorf("Projects.Create got error: %v", err)
+	}
+
+	want := &Project{
+		ID:   "1",
+		Slug: "gh/org1/prj1",
+	}
+
+	if !cmp.Equal(p, want) {
+		t.Errorf("Projects.Create got %+v, want %+v", p, want)
+	}
+}
+
+func Test_projects_Follow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+
+	mux.HandleFunc("/v1.1/project/github/org1/prj1/follow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"following": true, "workflow": true}`)
+	})
+
+	ctx := context.Background()
+	pf, err := client.Projects.Follow(ctx, projectSlug)
+	if err != nil {
+		t.Errorf("Projects.Follow got error: %v", err)
+	}
+
+	want := &ProjectFollowing{
+		Following: true,
+		Workflow:  true,
+	}
+
+	if !cmp.Equal(pf, want) {
+		t.Errorf("Projects.Follow got %+v, want %+v", pf, want)
+	}
+}
+
+func Test_projects_Unfollow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "bb/org1/prj1"
+
+	mux.HandleFunc("/v1.1/project/bitbucket/org1/prj1/unfollow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"following": false}`)
+	})
+
+	ctx := context.Background()
+	pf, err := client.Projects.Unfollow(ctx, projectSlug)
+	if err != nil {
+		t.Errorf("Projects.Unfollow got error: %v", err)
+	}
+
+	want := &ProjectFollowing{}
+
+	if !cmp.Equal(pf, want) {
+		t.Errorf("Projects.Unfollow got %+v, want %+v", pf, want)
+	}
+}
+
 func Test_projects_CreateCheckoutKey(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -311,6 +393,44 @@ func Test_projects_TriggerPipeline(t *testing.T) {
 	}
 }
 
+func Test_projects_RunPipeline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "circleci/org1/prj1"
+
+	mux.HandleFunc(fmt.Sprintf("/project/%s/pipeline/run", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"definition_id":"def1","config":{"branch":"main"},"checkout":{"tag":"v0.1.0"},"parameters":{"deploy_prod":true}}`+"\n")
+		fmt.Fprint(w, `{"id": "1","state": "created", "number": 1}`)
+	})
+
+	ctx := context.Background()
+	p, err := client.Projects.RunPipeline(ctx, projectSlug, ProjectRunPipelineOptions{
+		DefinitionID: String("def1"),
+		Config:       &PipelineRef{Branch: String("main")},
+		Checkout:     &PipelineRef{Tag: String("v0.1.0")},
+		Parameters: map[string]interface{}{
+			"deploy_prod": true,
+		},
+	})
+	if err != nil {
+		t.Errorf("Projects.RunPipeline got error: %v", err)
+	}
+
+	want := &Pipeline{
+		ID:     "1",
+		State:  "created",
+		Number: 1,
+	}
+
+	if !cmp.Equal(p, want) {
+		t.Errorf("Projects.RunPipeline got %+v, want %+v", p, want)
+	}
+}
+
 func Test_projects_ListPipelines(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,311 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type Runner interface {
+	ListResourceClasses(ctx context.Context, options RunnerListResourceClassesOptions) (*RunnerResourceClassList, error)
+	CreateResourceClass(ctx context.Context, options RunnerCreateResourceClassOptions) (*RunnerResourceClass, error)
+	DeleteResourceClass(ctx context.Context, id string) error
+	ListTokens(ctx context.Context, options RunnerListTokensOptions) (*RunnerTokenList, error)
+	CreateToken(ctx context.Context, options RunnerCreateTokenOptions) (*RunnerToken, error)
+	DeleteToken(ctx context.Context, id string) error
+	ListInstances(ctx context.Context, options RunnerListInstancesOptions) (*RunnerInstanceList, error)
+	GetUnclaimedTaskCount(ctx context.Context, resourceClass string) (int, error)
+	GetRunningTaskCount(ctx context.Context, resourceClass string) (int, error)
+}
+
+// runner implements Runner interface
+type runner struct {
+	client *Client
+}
+
+type RunnerResourceClassList struct {
+	Items []*RunnerResourceClass `json:"items"`
+}
+
+type RunnerResourceClass struct {
+	ID            string `json:"id"`
+	ResourceClass string `json:"resource_class"`
+	Description   string `json:"description"`
+}
+
+type RunnerListResourceClassesOptions struct {
+	Namespace *string `url:"namespace,omitempty"`
+}
+
+func (o RunnerListResourceClassesOptions) valid() error {
+	// Nothing is required
+	return nil
+}
+
+func (s *runner) ListResourceClasses(ctx context.Context, options RunnerListResourceClassesOptions) (*RunnerResourceClassList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "runner/resource"
+	req, err := s.client.newRunnerRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rrcl := &RunnerResourceClassList{}
+	err = s.client.do(ctx, req, rrcl)
+	if err != nil {
+		return nil, err
+	}
+
+	return rrcl, nil
+}
+
+type RunnerCreateResourceClassOptions struct {
+	ResourceClass *string `json:"resource_class"`
+	Description   *string `json:"description"`
+}
+
+func (o RunnerCreateResourceClassOptions) valid() error {
+	if !validString(o.ResourceClass) {
+		return ErrRequiredRunnerResourceClass
+	}
+
+	if !validString(o.Description) {
+		return ErrRequiredRunnerResourceClassDescription
+	}
+
+	return nil
+}
+
+func (s *runner) CreateResourceClass(ctx context.Context, options RunnerCreateResourceClassOptions) (*RunnerResourceClass, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "runner/resource"
+	req, err := s.client.newRunnerRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rrc := &RunnerResourceClass{}
+	err = s.client.do(ctx, req, rrc)
+	if err != nil {
+		return nil, err
+	}
+
+	return rrc, nil
+}
+
+func (s *runner) DeleteResourceClass(ctx context.Context, id string) error {
+	if !validString(&id) {
+		return ErrRequiredRunnerResourceClassID
+	}
+
+	u := fmt.Sprintf("runner/resource/%s", id)
+	req, err := s.client.newRunnerRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+type RunnerTokenList struct {
+	Items []*RunnerToken `json:"items"`
+}
+
+type RunnerToken struct {
+	ID            string    `json:"id"`
+	ResourceClass string    `json:"resource_class"`
+	Nickname      string    `json:"nickname"`
+	CreatedAt     time.Time `json:"created_at"`
+	// Token is only returned when the token is created.
+	Token string `json:"token,omitempty"`
+}
+
+type RunnerListTokensOptions struct {
+	ResourceClass *string `url:"resource-class,omitempty"`
+}
+
+func (o RunnerListTokensOptions) valid() error {
+	if !validString(o.ResourceClass) {
+		return ErrRequiredRunnerResourceClass
+	}
+
+	return nil
+}
+
+func (s *runner) ListTokens(ctx context.Context, options RunnerListTokensOptions) (*RunnerTokenList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "runner/token"
+	req, err := s.client.newRunnerRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rtl := &RunnerTokenList{}
+	err = s.client.do(ctx, req, rtl)
+	if err != nil {
+		return nil, err
+	}
+
+	return rtl, nil
+}
+
+type RunnerCreateTokenOptions struct {
+	ResourceClass *string `json:"resource_class"`
+	Nickname      *string `json:"nickname"`
+}
+
+func (o RunnerCreateTokenOptions) valid() error {
+	if !validString(o.ResourceClass) {
+		return ErrRequiredRunnerResourceClass
+	}
+
+	if !validString(o.Nickname) {
+		return ErrRequiredRunnerTokenNickname
+	}
+
+	return nil
+}
+
+func (s *runner) CreateToken(ctx context.Context, options RunnerCreateTokenOptions) (*RunnerToken, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "runner/token"
+	req, err := s.client.newRunnerRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &RunnerToken{}
+	err = s.client.do(ctx, req, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+func (s *runner) DeleteToken(ctx context.Context, id string) error {
+	if !validString(&id) {
+		return ErrRequiredRunnerTokenID
+	}
+
+	u := fmt.Sprintf("runner/token/%s", id)
+	req, err := s.client.newRunnerRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+type RunnerInstanceList struct {
+	Items []*RunnerInstance `json:"items"`
+}
+
+type RunnerInstance struct {
+	ResourceClass  string    `json:"resource_class"`
+	Hostname       string    `json:"hostname"`
+	Name           string    `json:"name"`
+	FirstConnected time.Time `json:"first_connected"`
+	LastConnected  time.Time `json:"last_connected"`
+	LastUsed       time.Time `json:"last_used"`
+	Version        string    `json:"version"`
+	IP             string    `json:"ip"`
+}
+
+type RunnerListInstancesOptions struct {
+	ResourceClass *string `url:"resource-class,omitempty"`
+	Namespace     *string `url:"namespace,omitempty"`
+}
+
+func (o RunnerListInstancesOptions) valid() error {
+	if !validString(o.ResourceClass) && !validString(o.Namespace) {
+		return ErrRequiredEitherRunnerResourceClassOrNamespace
+	}
+
+	return nil
+}
+
+func (s *runner) ListInstances(ctx context.Context, options RunnerListInstancesOptions) (*RunnerInstanceList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := "runner"
+	req, err := s.client.newRunnerRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	ril := &RunnerInstanceList{}
+	err = s.client.do(ctx, req, ril)
+	if err != nil {
+		return nil, err
+	}
+
+	return ril, nil
+}
+
+type runnerTaskCountOptions struct {
+	ResourceClass string `url:"resource-class"`
+}
+
+type runnerUnclaimedTaskCount struct {
+	UnclaimedTaskCount int `json:"unclaimed_task_count"`
+}
+
+func (s *runner) GetUnclaimedTaskCount(ctx context.Context, resourceClass string) (int, error) {
+	if !validString(&resourceClass) {
+		return 0, ErrRequiredRunnerResourceClass
+	}
+
+	u := "runner/tasks"
+	req, err := s.client.newRunnerRequest("GET", u, &runnerTaskCountOptions{ResourceClass: resourceClass})
+	if err != nil {
+		return 0, err
+	}
+
+	c := &runnerUnclaimedTaskCount{}
+	err = s.client.do(ctx, req, c)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.UnclaimedTaskCount, nil
+}
+
+type runnerRunningTaskCount struct {
+	RunningRunnerTasks int `json:"running_runner_tasks"`
+}
+
+func (s *runner) GetRunningTaskCount(ctx context.Context, resourceClass string) (int, error) {
+	if !validString(&resourceClass) {
+		return 0, ErrRequiredRunnerResourceClass
+	}
+
+	u := "runner/tasks/running"
+	req, err := s.client.newRunnerRequest("GET", u, &runnerTaskCountOptions{ResourceClass: resourceClass})
+	if err != nil {
+		return 0, err
+	}
+
+	c := &runnerRunningTaskCount{}
+	err = s.client.do(ctx, req, c)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.RunningRunnerTasks, nil
+}
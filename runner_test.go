@@ -0,0 +1,260 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_runner_ListResourceClasses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/runner/resource", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "namespace", "ns1")
+		fmt.Fprint(w, `{"items": [{"id": "1", "resource_class": "ns1/rc1"}]}`)
+	})
+
+	ctx := context.Background()
+	rrcl, err := client.Runner.ListResourceClasses(ctx, RunnerListResourceClassesOptions{
+		Namespace: String("ns1"),
+	})
+	if err != nil {
+		t.Errorf("Runner.ListResourceClasses got error: %v", err)
+	}
+
+	want := &RunnerResourceClassList{
+		Items: []*RunnerResourceClass{
+			{
+				ID:            "1",
+				ResourceClass: "ns1/rc1",
+			},
+		},
+	}
+
+	if !cmp.Equal(rrcl, want) {
+		t.Errorf("Runner.ListResourceClasses got %+v, want %+v", rrcl, want)
+	}
+}
+
+func Test_runner_CreateResourceClass(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/runner/resource", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"resource_class":"ns1/rc1","description":"desc1"}`+"\n")
+		fmt.Fprint(w, `{"id": "1", "resource_class": "ns1/rc1", "description": "desc1"}`)
+	})
+
+	ctx := context.Background()
+	rrc, err := client.Runner.CreateResourceClass(ctx, RunnerCreateResourceClassOptions{
+		ResourceClass: String("ns1/rc1"),
+		Description:   String("desc1"),
+	})
+	if err != nil {
+		t.Errorf("Runner.CreateResourceClass got error: %v", err)
+	}
+
+	want := &RunnerResourceClass{
+		ID:            "1",
+		ResourceClass: "ns1/rc1",
+		Description:   "desc1",
+	}
+
+	if !cmp.Equal(rrc, want) {
+		t.Errorf("Runner.CreateResourceClass got %+v, want %+v", rrc, want)
+	}
+}
+
+func Test_runner_DeleteResourceClass(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	id := "1"
+
+	mux.HandleFunc(fmt.Sprintf("/runner/resource/%s", id), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+	})
+
+	ctx := context.Background()
+	err := client.Runner.DeleteResourceClass(ctx, id)
+	if err != nil {
+		t.Errorf("Runner.DeleteResourceClass got error: %v", err)
+	}
+}
+
+func Test_runner_ListTokens(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/runner/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "resource-class", "ns1/rc1")
+		fmt.Fprint(w, `{"items": [{"id": "1", "nickname": "token1"}]}`)
+	})
+
+	ctx := context.Background()
+	rtl, err := client.Runner.ListTokens(ctx, RunnerListTokensOptions{
+		ResourceClass: String("ns1/rc1"),
+	})
+	if err != nil {
+		t.Errorf("Runner.ListTokens got error: %v", err)
+	}
+
+	want := &RunnerTokenList{
+		Items: []*RunnerToken{
+			{
+				ID:       "1",
+				Nickname: "token1",
+			},
+		},
+	}
+
+	if !cmp.Equal(rtl, want) {
+		t.Errorf("Runner.ListTokens got %+v, want %+v", rtl, want)
+	}
+}
+
+func Test_runner_CreateToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/runner/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"resource_class":"ns1/rc1","nickname":"token1"}`+"\n")
+		fmt.Fprint(w, `{"id": "1", "nickname": "token1", "token": "secret"}`)
+	})
+
+	ctx := context.Background()
+	rt, err := client.Runner.CreateToken(ctx, RunnerCreateTokenOptions{
+		ResourceClass: String("ns1/rc1"),
+		Nickname:      String("token1"),
+	})
+	if err != nil {
+		t.Errorf("Runner.CreateToken got error: %v", err)
+	}
+
+	want := &RunnerToken{
+		ID:       "1",
+		Nickname: "token1",
+		Token:    "secret",
+	}
+
+	if !cmp.Equal(rt, want) {
+		t.Errorf("Runner.CreateToken got %+v, want %+v", rt, want)
+	}
+}
+
+func Test_runner_DeleteToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	id := "1"
+
+	mux.HandleFunc(fmt.Sprintf("/runner/token/%s", id), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+	})
+
+	ctx := context.Background()
+	err := client.Runner.DeleteToken(ctx, id)
+	if err != nil {
+		t.Errorf("Runner.DeleteToken got error: %v", err)
+	}
+}
+
+func Test_runner_ListInstances(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/runner", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "resource-class", "ns1/rc1")
+		fmt.Fprint(w, `{"items": [{"resource_class": "ns1/rc1", "hostname": "host1"}]}`)
+	})
+
+	ctx := context.Background()
+	ril, err := client.Runner.ListInstances(ctx, RunnerListInstancesOptions{
+		ResourceClass: String("ns1/rc1"),
+	})
+	if err != nil {
+		t.Errorf("Runner.ListInstances got error: %v", err)
+	}
+
+	want := &RunnerInstanceList{
+		Items: []*RunnerInstance{
+			{
+				ResourceClass: "ns1/rc1",
+				Hostname:      "host1",
+			},
+		},
+	}
+
+	if !cmp.Equal(ril, want) {
+		t.Errorf("Runner.ListInstances got %+v, want %+v", ril, want)
+	}
+}
+
+func Test_runner_GetUnclaimedTaskCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/runner/tasks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "resource-class", "ns1/rc1")
+		fmt.Fprint(w, `{"unclaimed_task_count": 3}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.Runner.GetUnclaimedTaskCount(ctx, "ns1/rc1")
+	if err != nil {
+		t.Errorf("Runner.GetUnclaimedTaskCount got error: %v", err)
+	}
+
+	if c != 3 {
+		t.Errorf("Runner.GetUnclaimedTaskCount got %d, want %d", c, 3)
+	}
+}
+
+func Test_runner_GetRunningTaskCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/runner/tasks/running", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testQuery(t, r, "resource-class", "ns1/rc1")
+		fmt.Fprint(w, `{"running_runner_tasks": 2}`)
+	})
+
+	ctx := context.Background()
+	c, err := client.Runner.GetRunningTaskCount(ctx, "ns1/rc1")
+	if err != nil {
+		t.Errorf("Runner.GetRunningTaskCount got error: %v", err)
+	}
+
+	if c != 2 {
+		t.Errorf("Runner.GetRunningTaskCount got %d, want %d", c, 2)
+	}
+}
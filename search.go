@@ -0,0 +1,228 @@
+package circleci
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const defaultSearchConcurrency = 4
+
+// failedJobStatuses are the job statuses searched by SearchFailedJobLogs.
+var failedJobStatuses = map[string]bool{
+	"failed":              true,
+	"infrastructure_fail": true,
+	"timedout":            true,
+}
+
+type SearchLogsOptions struct {
+	// Concurrency bounds the number of jobs whose output is fetched at the
+	// same time. It defaults to 4.
+	Concurrency *int
+	// ContextLines is the number of lines included before and after each
+	// matching line.
+	ContextLines *int
+	// StripANSI removes ANSI escape sequences before matching.
+	StripANSI *bool
+}
+
+type LogMatch struct {
+	WorkflowID    string
+	WorkflowName  string
+	JobName       string
+	JobNumber     int64
+	StepName      string
+	ParallelIndex int
+	// LineNumber is the 1-based line number within the step output.
+	LineNumber int
+	Line       string
+	Before     []string
+	After      []string
+}
+
+type failedJob struct {
+	workflow *Workflow
+	job      *WorkflowJob
+}
+
+// SearchFailedJobLogs searches the step output of every failed job of a
+// pipeline for lines matching pattern.
+func (c *Client) SearchFailedJobLogs(ctx context.Context, pipelineID string, pattern *regexp.Regexp, options SearchLogsOptions) ([]*LogMatch, error) {
+	if !validString(&pipelineID) {
+		return nil, ErrRequiredPipelinePipelineID
+	}
+
+	if pattern == nil {
+		return nil, ErrRequiredSearchPattern
+	}
+
+	jobs, err := c.listFailedJobs(ctx, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := defaultSearchConcurrency
+	if options.Concurrency != nil && *options.Concurrency > 0 {
+		concurrency = *options.Concurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		matches  []*LogMatch
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, fj := range jobs {
+		wg.Add(1)
+		go func(fj failedJob) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			m, err := c.searchJobLogs(ctx, fj, pattern, options)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			matches = append(matches, m...)
+		}(fj)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	// Matches of a job are already in output order, only jobs need sorting.
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].JobNumber < matches[j].JobNumber
+	})
+
+	return matches, nil
+}
+
+func (c *Client) listFailedJobs(ctx context.Context, pipelineID string) ([]failedJob, error) {
+	var failed []failedJob
+
+	options := PipelineListWorkflowsOptions{}
+	for {
+		wl, err := c.Pipelines.ListWorkflows(ctx, pipelineID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			jl, err := c.Workflows.ListWorkflowJobs(ctx, w.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, j := range jl.Items {
+				// Jobs that never started, such as approvals, have no number.
+				if failedJobStatuses[j.Status] && j.JobNumber != 0 {
+					failed = append(failed, failedJob{workflow: w, job: j})
+				}
+			}
+		}
+
+		if wl.NextPageToken == "" {
+			return failed, nil
+		}
+		options.PageToken = String(wl.NextPageToken)
+	}
+}
+
+func (c *Client) searchJobLogs(ctx context.Context, fj failedJob, pattern *regexp.Regexp, options SearchLogsOptions) ([]*LogMatch, error) {
+	projectSlug := fj.job.ProjectSlug
+	if projectSlug == "" {
+		projectSlug = fj.workflow.ProjectSlug
+	}
+
+	steps, err := c.Jobs.ListSteps(ctx, projectSlug, strconv.FormatInt(fj.job.JobNumber, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	contextLines := 0
+	if options.ContextLines != nil && *options.ContextLines > 0 {
+		contextLines = *options.ContextLines
+	}
+
+	var matches []*LogMatch
+	for _, step := range steps {
+		for _, action := range step.Actions {
+			if !action.HasOutput {
+				continue
+			}
+
+			var buf bytes.Buffer
+			err := c.Jobs.StreamStepOutput(ctx, action, &buf, JobStreamStepOutputOptions{
+				StripANSI: options.StripANSI,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			lines := splitLines(buf.Bytes())
+			for i, line := range lines {
+				if !pattern.MatchString(line) {
+					continue
+				}
+
+				start, end := i-contextLines, i+1+contextLines
+				if start < 0 {
+					start = 0
+				}
+				if end > len(lines) {
+					end = len(lines)
+				}
+
+				matches = append(matches, &LogMatch{
+					WorkflowID:    fj.workflow.ID,
+					WorkflowName:  fj.workflow.Name,
+					JobName:       fj.job.Name,
+					JobNumber:     fj.job.JobNumber,
+					StepName:      step.Name,
+					ParallelIndex: action.Index,
+					LineNumber:    i + 1,
+					Line:          line,
+					Before:        lines[start:i],
+					After:         lines[i+1 : end],
+				})
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func splitLines(b []byte) []string {
+	var lines []string
+	s := bufio.NewScanner(bytes.NewReader(b))
+	s.Buffer(make([]byte, 0, 64*1024), len(b)+1)
+	for s.Scan() {
+		lines = append(lines, strings.TrimRight(s.Text(), "\r"))
+	}
+	return lines
+}
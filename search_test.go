@@ -0,0 +1,94 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Client_SearchFailedJobLogs(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	pipelineID := "pipeline1"
+
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s/workflow", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page-token") == "" {
+			fmt.Fprint(w, `{"items": [{"id": "workflow1", "name": "build", "project_slug": "gh/org1/prj1"}], "next_page_token": "2"}`)
+			return
+		}
+		fmt.Fprint(w, `{"items": [{"id": "workflow2", "name": "deploy", "project_slug": "gh/org1/prj1"}]}`)
+	})
+	mux.HandleFunc("/workflow/workflow1/job", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": [{"name": "lint", "job_number": 1, "status": "success"}, {"name": "test", "job_number": 2, "status": "failed"}]}`)
+	})
+	mux.HandleFunc("/workflow/workflow2/job", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": [{"name": "hold", "status": "failed"}, {"name": "deploy", "job_number": 3, "status": "failed"}]}`)
+	})
+	mux.HandleFunc("/v1.1/project/github/org1/prj1/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("steps of a successful job were fetched")
+	})
+	mux.HandleFunc("/v1.1/project/github/org1/prj1/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"steps": [{"name": "run tests", "actions": [
+			{"index": 0, "has_output": true, "output_url": "%[1]s/output/2/0"},
+			{"index": 1, "has_output": true, "output_url": "%[1]s/output/2/1"}
+		]}]}`, serverURL)
+	})
+	mux.HandleFunc("/v1.1/project/github/org1/prj1/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"steps": [{"name": "deploy", "actions": [{"index": 0, "has_output": true, "output_url": "%s/output/3/0"}]}]}`, serverURL)
+	})
+	mux.HandleFunc("/output/2/0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"message": "ok\n"}]`)
+	})
+	mux.HandleFunc("/output/2/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"message": "dialing db\r\n\u001b[31mdial tcp: connection refused\u001b[0m\r\n"}, {"message": "exit 1\n"}]`)
+	})
+	mux.HandleFunc("/output/3/0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"message": "connection refused\n"}]`)
+	})
+
+	ctx := context.Background()
+	matches, err := client.SearchFailedJobLogs(ctx, pipelineID, regexp.MustCompile(`connection refused`), SearchLogsOptions{
+		Concurrency:  Int(1),
+		ContextLines: Int(1),
+		StripANSI:    Bool(true),
+	})
+	if err != nil {
+		t.Errorf("Client.SearchFailedJobLogs got error: %v", err)
+	}
+
+	want := []*LogMatch{
+		{
+			WorkflowID:    "workflow1",
+			WorkflowName:  "build",
+			JobName:       "test",
+			JobNumber:     2,
+			StepName:      "run tests",
+			ParallelIndex: 1,
+			LineNumber:    2,
+			Line:          "dial tcp: connection refused",
+			Before:        []string{"dialing db"},
+			After:         []string{"exit 1"},
+		},
+		{
+			WorkflowID:   "workflow2",
+			WorkflowName: "deploy",
+			JobName:      "deploy",
+			JobNumber:    3,
+			StepName:     "deploy",
+			LineNumber:   1,
+			Line:         "connection refused",
+			Before:       []string{},
+			After:        []string{},
+		},
+	}
+
+	if !cmp.Equal(matches, want) {
+		t.Errorf("Client.SearchFailedJobLogs got %+v, want %+v", matches, want)
+	}
+}
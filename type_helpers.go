@@ -12,6 +12,11 @@ func Bool(v bool) *bool {
 	return &v
 }
 
+// Int returns a pointer to the given int.
+func Int(v int) *int {
+	return &v
+}
+
 // Time returns a pointer to the given time.Time
 func Time(v time.Time) *time.Time {
 	return &v
@@ -22,6 +27,11 @@ func ReportingWindow(v ReportingWindowType) *ReportingWindowType {
 	return &v
 }
 
+// Granularity returns a pointer to the given GranularityType.
+func Granularity(v GranularityType) *GranularityType {
+	return &v
+}
+
 // OwnerType returs a pointer to the given OwnerTypeType.
 func OwnerType(v OwnerTypeType) *OwnerTypeType {
 	return &v
@@ -36,3 +46,18 @@ func CheckoutKeyType(v CheckoutKeyTypeType) *CheckoutKeyTypeType {
 func EventType(v Event) *Event {
 	return &v
 }
+
+// PolicyDecisionStatus returns a pointer to the given PolicyDecisionStatusType
+func PolicyDecisionStatus(v PolicyDecisionStatusType) *PolicyDecisionStatusType {
+	return &v
+}
+
+// DeployReleaseType returns a pointer to the given DeployReleaseTypeType
+func DeployReleaseType(v DeployReleaseTypeType) *DeployReleaseTypeType {
+	return &v
+}
+
+// DeployReleaseStatus returns a pointer to the given DeployReleaseStatusType
+func DeployReleaseStatus(v DeployReleaseStatusType) *DeployReleaseStatusType {
+	return &v
+}
@@ -0,0 +1,346 @@
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mocks/$GOFILE
+package circleci
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+type Usage interface {
+	CreateExportJob(ctx context.Context, orgID string, options UsageCreateExportJobOptions) (*UsageExportJob, error)
+	GetExportJob(ctx context.Context, orgID, jobID string) (*UsageExportJob, error)
+	WaitForExportJob(ctx context.Context, orgID, jobID string, interval time.Duration) (*UsageExportJob, error)
+	DownloadExport(ctx context.Context, job *UsageExportJob, w io.Writer) error
+}
+
+// usage implements Usage interface
+type usage struct {
+	client *Client
+}
+
+type UsageExportJobStateType string
+
+const (
+	UsageExportJobStateCreated    UsageExportJobStateType = "created"
+	UsageExportJobStateProcessing UsageExportJobStateType = "processing"
+	UsageExportJobStateCompleted  UsageExportJobStateType = "completed"
+	UsageExportJobStateFailed     UsageExportJobStateType = "failed"
+)
+
+type UsageExportJob struct {
+	ID            string                  `json:"usage_export_job_id"`
+	State         UsageExportJobStateType `json:"state"`
+	Start         time.Time               `json:"start"`
+	End           time.Time               `json:"end"`
+	FailureReason string                  `json:"failure_reason"`
+	ErrorReason   string                  `json:"error_reason"`
+	DownloadURLs  []string                `json:"download_urls"`
+}
+
+type UsageCreateExportJobOptions struct {
+	Start        *time.Time `json:"start"`
+	End          *time.Time `json:"end"`
+	SharedOrgIDs []string   `json:"shared_org_ids,omitempty"`
+}
+
+func (o UsageCreateExportJobOptions) valid() error {
+	if o.Start == nil || o.Start.IsZero() {
+		return ErrRequiredUsageExportStart
+	}
+
+	if o.End == nil || o.End.IsZero() {
+		return ErrRequiredUsageExportEnd
+	}
+
+	if !o.End.After(*o.Start) {
+		return ErrInvalidUsageExportRange
+	}
+
+	return nil
+}
+
+func (s *usage) CreateExportJob(ctx context.Context, orgID string, options UsageCreateExportJobOptions) (*UsageExportJob, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	u := fmt.Sprintf("organizations/%s/usage_export_job", orgID)
+	req, err := s.client.newRequest("POST", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	uej := &UsageExportJob{}
+	err = s.client.do(ctx, req, uej)
+	if err != nil {
+		return nil, err
+	}
+
+	return uej, nil
+}
+
+func (s *usage) GetExportJob(ctx context.Context, orgID, jobID string) (*UsageExportJob, error) {
+	if !validString(&orgID) {
+		return nil, ErrRequiredOrganizationID
+	}
+
+	if !validString(&jobID) {
+		return nil, ErrRequiredUsageExportJobID
+	}
+
+	u := fmt.Sprintf("organizations/%s/usage_export_job/%s", orgID, jobID)
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uej := &UsageExportJob{}
+	err = s.client.do(ctx, req, uej)
+	if err != nil {
+		return nil, err
+	}
+
+	return uej, nil
+}
+
+// WaitForExportJob polls the export job every interval until it has either
+// completed or failed. A failed job is returned along with an error wrapping
+// ErrUsageExportJobFailed. A non-positive interval defaults to 5s.
+func (s *usage) WaitForExportJob(ctx context.Context, orgID, jobID string, interval time.Duration) (*UsageExportJob, error) {
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		uej, err := s.GetExportJob(ctx, orgID, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch uej.State {
+		case UsageExportJobStateCompleted:
+			return uej, nil
+		case UsageExportJobStateFailed:
+			return uej, fmt.Errorf("%w: %s", ErrUsageExportJobFailed, uej.FailureReason)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DownloadExport streams the CSV files of a completed export job to w, one
+// after another. Files are decompressed when they are served gzipped.
+func (s *usage) DownloadExport(ctx context.Context, job *UsageExportJob, w io.Writer) error {
+	if job == nil || job.State != UsageExportJobStateCompleted {
+		return ErrUsageExportJobNotCompleted
+	}
+
+	for _, u := range job.DownloadURLs {
+		if err := s.download(ctx, u, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *usage) download(ctx context.Context, u string, w io.Writer) error {
+	body, err := s.client.openExternal(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	_, err = io.Copy(w, body)
+	return err
+}
+
+// UsageRecord is a single row of a usage export. Only the most commonly used
+// columns are decoded.
+type UsageRecord struct {
+	OrganizationID        string    `csv:"ORGANIZATION_ID"`
+	OrganizationName      string    `csv:"ORGANIZATION_NAME"`
+	ProjectID             string    `csv:"PROJECT_ID"`
+	ProjectName           string    `csv:"PROJECT_NAME"`
+	VCSName               string    `csv:"VCS_NAME"`
+	VCSURL                string    `csv:"VCS_URL"`
+	VCSBranch             string    `csv:"VCS_BRANCH"`
+	PipelineID            string    `csv:"PIPELINE_ID"`
+	PipelineNumber        int64     `csv:"PIPELINE_NUMBER"`
+	PipelineCreatedAt     time.Time `csv:"PIPELINE_CREATED_AT"`
+	PipelineTriggerSource string    `csv:"PIPELINE_TRIGGER_SOURCE"`
+	WorkflowID            string    `csv:"WORKFLOW_ID"`
+	WorkflowName          string    `csv:"WORKFLOW_NAME"`
+	IsWorkflowSuccessful  bool      `csv:"IS_WORKFLOW_SUCCESSFUL"`
+	JobID                 string    `csv:"JOB_ID"`
+	JobName               string    `csv:"JOB_NAME"`
+	JobRunNumber          int64     `csv:"JOB_RUN_NUMBER"`
+	JobRunStartedAt       time.Time `csv:"JOB_RUN_STARTED_AT"`
+	JobRunStoppedAt       time.Time `csv:"JOB_RUN_STOPPED_AT"`
+	JobBuildStatus        string    `csv:"JOB_BUILD_STATUS"`
+	JobRunSeconds         float64   `csv:"JOB_RUN_SECONDS"`
+	ResourceClass         string    `csv:"RESOURCE_CLASS"`
+	OperatingSystem       string    `csv:"OPERATING_SYSTEM"`
+	Executor              string    `csv:"EXECUTOR"`
+	Parallelism           int64     `csv:"PARALLELISM"`
+	ComputeCredits        float64   `csv:"COMPUTE_CREDITS"`
+	DLCCredits            float64   `csv:"DLC_CREDITS"`
+	UserCredits           float64   `csv:"USER_CREDITS"`
+	StorageCredits        float64   `csv:"STORAGE_CREDITS"`
+	NetworkCredits        float64   `csv:"NETWORK_CREDITS"`
+	TotalCredits          float64   `csv:"TOTAL_CREDITS"`
+}
+
+// UsageRecordReader decodes UsageRecords from the CSV written by
+// Usage.DownloadExport. Header rows repeated by concatenated files are
+// skipped.
+type UsageRecordReader struct {
+	r       *csv.Reader
+	header  []string
+	columns map[int]int
+}
+
+func NewUsageRecordReader(r io.Reader) *UsageRecordReader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	return &UsageRecordReader{r: cr}
+}
+
+// Read returns the next record, or io.EOF when there are no more records.
+func (r *UsageRecordReader) Read() (*UsageRecord, error) {
+	for {
+		row, err := r.r.Read()
+		if err != nil {
+			return nil, err
+		}
+
+		if r.header == nil {
+			r.setHeader(row)
+			continue
+		}
+
+		if r.isHeader(row) {
+			continue
+		}
+
+		return r.decode(row)
+	}
+}
+
+func (r *UsageRecordReader) setHeader(row []string) {
+	r.header = append([]string(nil), row...)
+	r.columns = make(map[int]int)
+
+	t := reflect.TypeOf(UsageRecord{})
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		fields[t.Field(i).Tag.Get("csv")] = i
+	}
+
+	for col, name := range r.header {
+		if i, ok := fields[name]; ok {
+			r.columns[col] = i
+		}
+	}
+}
+
+func (r *UsageRecordReader) isHeader(row []string) bool {
+	if len(row) != len(r.header) {
+		return false
+	}
+
+	for i := range row {
+		if row[i] != r.header[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *UsageRecordReader) decode(row []string) (*UsageRecord, error) {
+	rec := &UsageRecord{}
+	v := reflect.ValueOf(rec).Elem()
+
+	for col, i := range r.columns {
+		if col >= len(row) || row[col] == "" {
+			continue
+		}
+
+		if err := setUsageField(v.Field(i), row[col]); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", r.header[col], row[col], err)
+		}
+	}
+
+	return rec, nil
+}
+
+func setUsageField(f reflect.Value, s string) error {
+	switch f.Interface().(type) {
+	case string:
+		f.SetString(s)
+	case int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case float64:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		f.SetFloat(n)
+	case bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case time.Time:
+		t, err := parseUsageTime(s)
+		if err != nil {
+			return err
+		}
+		f.Set(reflect.ValueOf(t))
+	}
+
+	return nil
+}
+
+var usageTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999 -0700",
+	"2006-01-02",
+}
+
+func parseUsageTime(s string) (time.Time, error) {
+	var err error
+	for _, layout := range usageTimeLayouts {
+		var t time.Time
+		t, err = time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
@@ -0,0 +1,211 @@
+package circleci
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_usage_CreateExportJob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc(fmt.Sprintf("/organizations/%s/usage_export_job", orgID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		testBody(t, r, `{"start":"2022-01-01T00:00:00Z","end":"2022-01-31T00:00:00Z"}`+"\n")
+		fmt.Fprint(w, `{"usage_export_job_id": "1", "state": "created"}`)
+	})
+
+	ctx := context.Background()
+	uej, err := client.Usage.CreateExportJob(ctx, orgID, UsageCreateExportJobOptions{
+		Start: Time(start),
+		End:   Time(end),
+	})
+	if err != nil {
+		t.Errorf("Usage.CreateExportJob got error: %v", err)
+	}
+
+	want := &UsageExportJob{
+		ID:    "1",
+		State: UsageExportJobStateCreated,
+	}
+
+	if !cmp.Equal(uej, want) {
+		t.Errorf("Usage.CreateExportJob got %+v, want %+v", uej, want)
+	}
+}
+
+func Test_usage_GetExportJob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+	jobID := "job1"
+
+	mux.HandleFunc(fmt.Sprintf("/organizations/%s/usage_export_job/%s", orgID, jobID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Circle-Token", client.token)
+		fmt.Fprint(w, `{"usage_export_job_id": "job1", "state": "completed", "download_urls": ["https://example.com/1.csv.gz"]}`)
+	})
+
+	ctx := context.Background()
+	uej, err := client.Usage.GetExportJob(ctx, orgID, jobID)
+	if err != nil {
+		t.Errorf("Usage.GetExportJob got error: %v", err)
+	}
+
+	want := &UsageExportJob{
+		ID:           jobID,
+		State:        UsageExportJobStateCompleted,
+		DownloadURLs: []string{"https://example.com/1.csv.gz"},
+	}
+
+	if !cmp.Equal(uej, want) {
+		t.Errorf("Usage.GetExportJob got %+v, want %+v", uej, want)
+	}
+}
+
+func Test_usage_WaitForExportJob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+	jobID := "job1"
+	states := []string{"created", "processing", "failed"}
+
+	var calls int
+	mux.HandleFunc(fmt.Sprintf("/organizations/%s/usage_export_job/%s", orgID, jobID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"usage_export_job_id": "job1", "state": "%s", "failure_reason": "boom"}`, states[calls])
+		calls++
+	})
+
+	ctx := context.Background()
+	uej, err := client.Usage.WaitForExportJob(ctx, orgID, jobID, time.Millisecond)
+	if !errors.Is(err, ErrUsageExportJobFailed) {
+		t.Errorf("Usage.WaitForExportJob got error %v, want %v", err, ErrUsageExportJobFailed)
+	}
+
+	if calls != len(states) {
+		t.Errorf("Usage.WaitForExportJob polled %d times, want %d", calls, len(states))
+	}
+
+	if uej == nil || uej.State != UsageExportJobStateFailed {
+		t.Errorf("Usage.WaitForExportJob got %+v, want a failed job", uej)
+	}
+}
+
+func Test_usage_WaitForExportJob_zeroInterval(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	orgID := "org1"
+	jobID := "job1"
+
+	mux.HandleFunc(fmt.Sprintf("/organizations/%s/usage_export_job/%s", orgID, jobID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"usage_export_job_id": "job1", "state": "completed"}`)
+	})
+
+	ctx := context.Background()
+	uej, err := client.Usage.WaitForExportJob(ctx, orgID, jobID, 0)
+	if err != nil {
+		t.Fatalf("Usage.WaitForExportJob got error: %v", err)
+	}
+
+	if uej.State != UsageExportJobStateCompleted {
+		t.Errorf("Usage.WaitForExportJob got %+v, want a completed job", uej)
+	}
+}
+
+func Test_usage_DownloadExport(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/files/1.csv.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Circle-Token", "")
+		gw := gzip.NewWriter(w)
+		fmt.Fprint(gw, "PROJECT_NAME,TOTAL_CREDITS\nprj1,10\n")
+		gw.Close()
+	})
+	mux.HandleFunc("/files/2.csv", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "PROJECT_NAME,TOTAL_CREDITS\nprj2,2.5\n")
+	})
+
+	job := &UsageExportJob{
+		State: UsageExportJobStateCompleted,
+		DownloadURLs: []string{
+			serverURL + "/files/1.csv.gz",
+			serverURL + "/files/2.csv",
+		},
+	}
+
+	var buf bytes.Buffer
+	ctx := context.Background()
+	err := client.Usage.DownloadExport(ctx, job, &buf)
+	if err != nil {
+		t.Errorf("Usage.DownloadExport got error: %v", err)
+	}
+
+	want := "PROJECT_NAME,TOTAL_CREDITS\nprj1,10\nPROJECT_NAME,TOTAL_CREDITS\nprj2,2.5\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Usage.DownloadExport got %q, want %q", got, want)
+	}
+}
+
+func Test_UsageRecordReader_Read(t *testing.T) {
+	in := strings.Join([]string{
+		"PROJECT_NAME,JOB_RUN_NUMBER,IS_WORKFLOW_SUCCESSFUL,JOB_RUN_STARTED_AT,TOTAL_CREDITS,UNKNOWN",
+		"prj1,12,true,2022-01-02 03:04:05.000,10.5,x",
+		"PROJECT_NAME,JOB_RUN_NUMBER,IS_WORKFLOW_SUCCESSFUL,JOB_RUN_STARTED_AT,TOTAL_CREDITS,UNKNOWN",
+		"prj2,,false,,1,y",
+	}, "\n")
+
+	r := NewUsageRecordReader(strings.NewReader(in))
+
+	var got []*UsageRecord
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("UsageRecordReader.Read got error: %v", err)
+		}
+		got = append(got, rec)
+	}
+
+	want := []*UsageRecord{
+		{
+			ProjectName:          "prj1",
+			JobRunNumber:         12,
+			IsWorkflowSuccessful: true,
+			JobRunStartedAt:      time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+			TotalCredits:         10.5,
+		},
+		{
+			ProjectName:  "prj2",
+			TotalCredits: 1,
+		},
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("UsageRecordReader.Read got %+v, want %+v", got, want)
+	}
+}
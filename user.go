@@ -40,8 +40,10 @@ func (s *users) Me(ctx context.Context) (*User, error) {
 }
 
 type Collaboration struct {
+	ID        string `json:"id"`
 	VcsType   string `json:"vcs-type"`
 	Name      string `json:"name"`
+	Slug      string `json:"slug"`
 	AvatarURL string `json:"avatar_url"`
 }
 
@@ -45,7 +45,7 @@ func Test_users_Collaborations(t *testing.T) {
 		testMethod(t, r, "GET")
 		testHeader(t, r, "Accept", "application/json")
 		testHeader(t, r, "Circle-Token", client.token)
-		fmt.Fprint(w, `[{"vcs-type": "vcs1", "name": "name1", "avatar_url": "avatar1"}]`)
+		fmt.Fprint(w, `[{"id": "id1", "vcs-type": "vcs1", "name": "name1", "slug": "gh/name1", "avatar_url": "avatar1"}]`)
 	})
 
 	ctx := context.Background()
@@ -56,8 +56,10 @@ func Test_users_Collaborations(t *testing.T) {
 
 	want := []*Collaboration{
 		{
+			ID:        "id1",
 			VcsType:   "vcs1",
 			Name:      "name1",
+			Slug:      "gh/name1",
 			AvatarURL: "avatar1",
 		},
 	}
@@ -0,0 +1,345 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultWaitInterval        = 5 * time.Second
+	defaultContinuationTimeout = time.Minute
+	defaultNoWorkflowsTimeout  = time.Minute
+)
+
+// Workflow statuses. Workflow.Status holds one of these as a string.
+const (
+	WorkflowStatusSuccess      = "success"
+	WorkflowStatusRunning      = "running"
+	WorkflowStatusNotRun       = "not_run"
+	WorkflowStatusFailed       = "failed"
+	WorkflowStatusError        = "error"
+	WorkflowStatusFailing      = "failing"
+	WorkflowStatusOnHold       = "on_hold"
+	WorkflowStatusCanceled     = "canceled"
+	WorkflowStatusUnauthorized = "unauthorized"
+)
+
+// finalWorkflowStatuses are the statuses a workflow doesn't leave.
+var finalWorkflowStatuses = map[string]bool{
+	WorkflowStatusSuccess:      true,
+	WorkflowStatusNotRun:       true,
+	WorkflowStatusFailed:       true,
+	WorkflowStatusError:        true,
+	WorkflowStatusCanceled:     true,
+	WorkflowStatusUnauthorized: true,
+}
+
+type WaitOptions struct {
+	// Interval is the time between the first polls. It defaults to 5s.
+	Interval time.Duration
+	// Backoff multiplies the interval after each poll, up to MaxInterval.
+	// Values below 1 disable backoff.
+	Backoff     float64
+	MaxInterval time.Duration
+	// Timeout bounds the whole wait. ErrWaitTimeout is returned along with
+	// the last known state when it expires. Zero means no timeout.
+	Timeout time.Duration
+	// IncludeJobs fetches the jobs of each workflow on every poll.
+	IncludeJobs bool
+	// StopOnHold stops waiting once every workflow is either done or on
+	// hold waiting for an approval. Otherwise approvals are waited for.
+	StopOnHold bool
+	// ContinuationTimeout is how long to wait for a setup workflow that
+	// succeeded to continue the pipeline. It defaults to 1m.
+	ContinuationTimeout time.Duration
+	// NoWorkflowsTimeout is how long to wait for a created pipeline to get
+	// workflows. A pipeline still without any by then, e.g. because every
+	// workflow was filtered out, is returned as done with no workflows. It
+	// defaults to 1m.
+	NoWorkflowsTimeout time.Duration
+	// OnEvent and Events receive an event each time the status of a
+	// workflow or job changes. Sends on Events block until the event is
+	// received or the wait ends.
+	OnEvent func(*WaitEvent)
+	Events  chan<- *WaitEvent
+}
+
+// WaitEvent reports a status change. Exactly one of Workflow and Job is
+// set. Job events carry the workflow the job belongs to in Workflow as well.
+type WaitEvent struct {
+	Time     time.Time
+	Workflow *Workflow
+	Job      *WorkflowJob
+}
+
+type WorkflowWaitResult struct {
+	Workflow *Workflow
+	// Jobs is only set when WaitOptions.IncludeJobs is.
+	Jobs []*WorkflowJob
+}
+
+type PipelineWaitResult struct {
+	Pipeline  *Pipeline
+	Workflows []*WorkflowWaitResult
+}
+
+// waiter keeps the state of a wait across polls.
+type waiter struct {
+	client   *Client
+	options  WaitOptions
+	interval time.Duration
+	statuses map[string]string
+}
+
+func (c *Client) newWaiter(options WaitOptions) *waiter {
+	if options.Interval <= 0 {
+		options.Interval = defaultWaitInterval
+	}
+	if options.MaxInterval < options.Interval {
+		options.MaxInterval = options.Interval
+	}
+	if options.ContinuationTimeout <= 0 {
+		options.ContinuationTimeout = defaultContinuationTimeout
+	}
+	if options.NoWorkflowsTimeout <= 0 {
+		options.NoWorkflowsTimeout = defaultNoWorkflowsTimeout
+	}
+	return &waiter{
+		client:   c,
+		options:  options,
+		interval: options.Interval,
+		statuses: map[string]string{},
+	}
+}
+
+// WaitForPipeline polls the pipeline until every workflow of it is done and
+// returns their final state. Pipelines run from a setup workflow are waited
+// for until the workflows they are continued with are done as well.
+func (c *Client) WaitForPipeline(ctx context.Context, pipelineID string, options WaitOptions) (*PipelineWaitResult, error) {
+	if !validString(&pipelineID) {
+		return nil, ErrRequiredPipelinePipelineID
+	}
+
+	w := c.newWaiter(options)
+	ctx, cancel := w.withTimeout(ctx)
+	defer cancel()
+
+	var (
+		result          *PipelineWaitResult
+		setupWorkflows  int
+		setupFinishedAt time.Time
+		createdAt       time.Time
+	)
+	for {
+		r, err := w.pollPipeline(ctx, pipelineID)
+		if err != nil {
+			return result, w.wrapErr(ctx, err)
+		}
+		result = r
+
+		if r.Pipeline.State == "errored" {
+			return result, ErrPipelineErrored
+		}
+
+		if len(r.Workflows) == 0 && r.Pipeline.State == "created" {
+			if createdAt.IsZero() {
+				createdAt = time.Now()
+			}
+			if time.Since(createdAt) >= w.options.NoWorkflowsTimeout {
+				return result, nil
+			}
+		}
+
+		if len(r.Workflows) > 0 && w.done(r.Workflows) {
+			if !isSetupPipeline(r.Pipeline) || !allSucceeded(r.Workflows) {
+				return result, nil
+			}
+			// A setup pipeline is done once the workflows it was continued
+			// with are, or when it doesn't get continued in time.
+			if setupWorkflows == 0 {
+				setupWorkflows, setupFinishedAt = len(r.Workflows), time.Now()
+			}
+			if len(r.Workflows) > setupWorkflows || time.Since(setupFinishedAt) >= w.options.ContinuationTimeout {
+				return result, nil
+			}
+		}
+
+		if err := w.sleep(ctx); err != nil {
+			return result, err
+		}
+	}
+}
+
+// WaitForWorkflow polls the workflow until it is done and returns its final
+// state.
+func (c *Client) WaitForWorkflow(ctx context.Context, workflowID string, options WaitOptions) (*WorkflowWaitResult, error) {
+	if !validString(&workflowID) {
+		return nil, ErrRequiredWorkflowID
+	}
+
+	w := c.newWaiter(options)
+	ctx, cancel := w.withTimeout(ctx)
+	defer cancel()
+
+	var result *WorkflowWaitResult
+	for {
+		wf, err := c.Workflows.Get(ctx, workflowID)
+		if err != nil {
+			return result, w.wrapErr(ctx, err)
+		}
+
+		r, err := w.pollWorkflow(ctx, wf)
+		if err != nil {
+			return result, w.wrapErr(ctx, err)
+		}
+		result = r
+
+		if w.done([]*WorkflowWaitResult{r}) {
+			return result, nil
+		}
+
+		if err := w.sleep(ctx); err != nil {
+			return result, err
+		}
+	}
+}
+
+func (w *waiter) pollPipeline(ctx context.Context, pipelineID string) (*PipelineWaitResult, error) {
+	p, err := w.client.Pipelines.Get(ctx, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PipelineWaitResult{Pipeline: p}
+	options := PipelineListWorkflowsOptions{}
+	for {
+		wl, err := w.client.Pipelines.ListWorkflows(ctx, pipelineID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, wf := range wl.Items {
+			wr, err := w.pollWorkflow(ctx, wf)
+			if err != nil {
+				return nil, err
+			}
+			r.Workflows = append(r.Workflows, wr)
+		}
+
+		if wl.NextPageToken == "" {
+			break
+		}
+		options.PageToken = String(wl.NextPageToken)
+	}
+
+	return r, nil
+}
+
+func (w *waiter) pollWorkflow(ctx context.Context, wf *Workflow) (*WorkflowWaitResult, error) {
+	r := &WorkflowWaitResult{Workflow: wf}
+	w.notify(ctx, wf.ID, workflowStatus(wf), &WaitEvent{Workflow: wf})
+
+	if !w.options.IncludeJobs {
+		return r, nil
+	}
+
+	jl, err := w.client.Workflows.ListWorkflowJobs(ctx, wf.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, j := range jl.Items {
+		w.notify(ctx, wf.ID+"/"+j.ID, j.Status, &WaitEvent{Workflow: wf, Job: j})
+	}
+	r.Jobs = jl.Items
+
+	return r, nil
+}
+
+// notify emits e when the status of the workflow or job identified by id
+// changed since the last poll.
+func (w *waiter) notify(ctx context.Context, id, status string, e *WaitEvent) {
+	if w.statuses[id] == status {
+		return
+	}
+	w.statuses[id] = status
+
+	e.Time = time.Now()
+	if w.options.OnEvent != nil {
+		w.options.OnEvent(e)
+	}
+	if w.options.Events != nil {
+		select {
+		case w.options.Events <- e:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (w *waiter) done(workflows []*WorkflowWaitResult) bool {
+	for _, wr := range workflows {
+		status := workflowStatus(wr.Workflow)
+		if finalWorkflowStatuses[status] {
+			continue
+		}
+		if status == WorkflowStatusOnHold && w.options.StopOnHold {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func (w *waiter) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if w.options.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, w.options.Timeout)
+}
+
+// sleep waits for the current interval and backs it off for the next poll.
+func (w *waiter) sleep(ctx context.Context) error {
+	t := time.NewTimer(w.interval)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return w.wrapErr(ctx, ctx.Err())
+	case <-t.C:
+	}
+
+	if w.options.Backoff > 1 {
+		w.interval = time.Duration(float64(w.interval) * w.options.Backoff)
+		if w.interval > w.options.MaxInterval {
+			w.interval = w.options.MaxInterval
+		}
+	}
+	return nil
+}
+
+// wrapErr reports the expiry of WaitOptions.Timeout as ErrWaitTimeout rather
+// than as the deadline of ctx.
+func (w *waiter) wrapErr(ctx context.Context, err error) error {
+	if w.options.Timeout > 0 && ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("%w after %s", ErrWaitTimeout, w.options.Timeout)
+	}
+	return err
+}
+
+func workflowStatus(wf *Workflow) string {
+	s, _ := wf.Status.(string)
+	return s
+}
+
+func isSetupPipeline(p *Pipeline) bool {
+	return p.State == "setup" || p.State == "setup-pending"
+}
+
+func allSucceeded(workflows []*WorkflowWaitResult) bool {
+	for _, wr := range workflows {
+		if workflowStatus(wr.Workflow) != WorkflowStatusSuccess {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,218 @@
+package circleci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_Client_WaitForPipeline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	pipelineID := "pipeline1"
+	var polls int32
+
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		fmt.Fprint(w, `{"id": "pipeline1", "state": "created"}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s/workflow", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		status := "running"
+		if atomic.LoadInt32(&polls) >= 3 {
+			status = "success"
+		}
+		fmt.Fprintf(w, `{"items": [{"id": "workflow1", "name": "build", "status": "%s"}]}`, status)
+	})
+	mux.HandleFunc("/workflow/workflow1/job", func(w http.ResponseWriter, r *http.Request) {
+		status := "running"
+		if atomic.LoadInt32(&polls) >= 2 {
+			status = "success"
+		}
+		fmt.Fprintf(w, `{"items": [{"id": "job1", "name": "test", "status": "%s"}]}`, status)
+	})
+
+	var events []string
+	ctx := context.Background()
+	r, err := client.WaitForPipeline(ctx, pipelineID, WaitOptions{
+		Interval:    time.Millisecond,
+		Backoff:     2,
+		MaxInterval: 4 * time.Millisecond,
+		IncludeJobs: true,
+		OnEvent: func(e *WaitEvent) {
+			if e.Job != nil {
+				events = append(events, "job "+e.Job.Name+" "+e.Job.Status)
+				return
+			}
+			events = append(events, "workflow "+e.Workflow.Name+" "+workflowStatus(e.Workflow))
+		},
+	})
+	if err != nil {
+		t.Fatalf("Client.WaitForPipeline got error: %v", err)
+	}
+
+	if len(r.Workflows) != 1 || workflowStatus(r.Workflows[0].Workflow) != WorkflowStatusSuccess || len(r.Workflows[0].Jobs) != 1 {
+		t.Errorf("Client.WaitForPipeline got %+v", r)
+	}
+
+	want := []string{
+		"workflow build running",
+		"job test running",
+		"job test success",
+		"workflow build success",
+	}
+	if fmt.Sprint(events) != fmt.Sprint(want) {
+		t.Errorf("Client.WaitForPipeline emitted %v, want %v", events, want)
+	}
+}
+
+func Test_Client_WaitForPipeline_setup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	pipelineID := "pipeline1"
+	var polls int32
+
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		fmt.Fprint(w, `{"id": "pipeline1", "state": "setup"}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s/workflow", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		switch n := atomic.LoadInt32(&polls); {
+		case n <= 2:
+			fmt.Fprint(w, `{"items": [{"id": "setup1", "name": "setup", "status": "success"}]}`)
+		case n == 3:
+			fmt.Fprint(w, `{"items": [{"id": "setup1", "name": "setup", "status": "success"}, {"id": "workflow1", "name": "build", "status": "running"}]}`)
+		default:
+			fmt.Fprint(w, `{"items": [{"id": "setup1", "name": "setup", "status": "success"}, {"id": "workflow1", "name": "build", "status": "failed"}]}`)
+		}
+	})
+
+	ctx := context.Background()
+	r, err := client.WaitForPipeline(ctx, pipelineID, WaitOptions{
+		Interval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Client.WaitForPipeline got error: %v", err)
+	}
+
+	if len(r.Workflows) != 2 || workflowStatus(r.Workflows[1].Workflow) != WorkflowStatusFailed {
+		t.Errorf("Client.WaitForPipeline got %+v, want the continued workflow to have failed", r.Workflows)
+	}
+}
+
+func Test_Client_WaitForPipeline_timeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	pipelineID := "pipeline1"
+
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "pipeline1", "state": "created"}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s/workflow", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": [{"id": "workflow1", "status": "running"}]}`)
+	})
+
+	ctx := context.Background()
+	r, err := client.WaitForPipeline(ctx, pipelineID, WaitOptions{
+		Interval: time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+	})
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("Client.WaitForPipeline got error %v, want %v", err, ErrWaitTimeout)
+	}
+	if r == nil || len(r.Workflows) != 1 {
+		t.Errorf("Client.WaitForPipeline got %+v, want the last known state", r)
+	}
+}
+
+func Test_Client_WaitForPipeline_noWorkflows(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	pipelineID := "pipeline1"
+
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "pipeline1", "state": "created"}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s/workflow", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": []}`)
+	})
+
+	ctx := context.Background()
+	r, err := client.WaitForPipeline(ctx, pipelineID, WaitOptions{
+		Interval:           time.Millisecond,
+		NoWorkflowsTimeout: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Client.WaitForPipeline got error: %v", err)
+	}
+	if r == nil || r.Pipeline.ID != pipelineID || len(r.Workflows) != 0 {
+		t.Errorf("Client.WaitForPipeline got %+v, want the pipeline with no workflows", r)
+	}
+}
+
+func Test_Client_WaitForPipeline_blockedEvents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	pipelineID := "pipeline1"
+
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "pipeline1", "state": "created"}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/pipeline/%s/workflow", pipelineID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": [{"id": "workflow1", "status": "running"}]}`)
+	})
+
+	// Nobody receives from events, which must not outlast the timeout.
+	events := make(chan *WaitEvent)
+	ctx := context.Background()
+	_, err := client.WaitForPipeline(ctx, pipelineID, WaitOptions{
+		Interval: time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+		Events:   events,
+	})
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("Client.WaitForPipeline got error %v, want %v", err, ErrWaitTimeout)
+	}
+}
+
+func Test_Client_WaitForWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	workflowID := "workflow1"
+	var polls int32
+
+	mux.HandleFunc(fmt.Sprintf("/workflow/%s", workflowID), func(w http.ResponseWriter, r *http.Request) {
+		status := "running"
+		if atomic.AddInt32(&polls, 1) >= 2 {
+			status = "on_hold"
+		}
+		fmt.Fprintf(w, `{"id": "workflow1", "status": "%s"}`, status)
+	})
+
+	events := make(chan *WaitEvent, 10)
+	ctx := context.Background()
+	r, err := client.WaitForWorkflow(ctx, workflowID, WaitOptions{
+		Interval:   time.Millisecond,
+		StopOnHold: true,
+		Events:     events,
+	})
+	if err != nil {
+		t.Fatalf("Client.WaitForWorkflow got error: %v", err)
+	}
+
+	if workflowStatus(r.Workflow) != WorkflowStatusOnHold {
+		t.Errorf("Client.WaitForWorkflow got status %v, want %s", r.Workflow.Status, WorkflowStatusOnHold)
+	}
+	if len(events) != 2 {
+		t.Errorf("Client.WaitForWorkflow emitted %d events, want 2", len(events))
+	}
+}
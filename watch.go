@@ -0,0 +1,343 @@
+package circleci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWatchInterval     = 10 * time.Second
+	defaultWatchMaxPipelines = 20
+)
+
+type WatchEventType string
+
+const (
+	WatchEventPipelineCreated       WatchEventType = "pipeline-created"
+	WatchEventWorkflowStatusChanged WatchEventType = "workflow-status-changed"
+	WatchEventJobStarted            WatchEventType = "job-started"
+	WatchEventJobFinished           WatchEventType = "job-finished"
+	WatchEventApprovalPending       WatchEventType = "approval-pending"
+)
+
+// finishedJobStatuses are the statuses reported by WatchEventJobFinished.
+var finishedJobStatuses = map[string]bool{
+	"success":             true,
+	"failed":              true,
+	"canceled":            true,
+	"not_run":             true,
+	"infrastructure_fail": true,
+	"timedout":            true,
+	"unauthorized":        true,
+	"terminated-unknown":  true,
+}
+
+type WatchOptions struct {
+	// Branch only watches the pipelines of the branch.
+	Branch *string
+	// Types only emits events of these types. All events are emitted when
+	// it is empty.
+	Types []WatchEventType
+	// Interval is the time between two polls. It defaults to 10s.
+	Interval time.Duration
+	// MaxPipelines is the number of most recent pipelines watched. It
+	// defaults to 20.
+	MaxPipelines int
+	// Cursor resumes a previous watch from the WatchEvent.Cursor of the last
+	// event it delivered. Without it, the state found by the first poll is
+	// taken as is and only later changes are emitted.
+	Cursor string
+}
+
+type WatchEvent struct {
+	Type     WatchEventType
+	Time     time.Time
+	Pipeline *Pipeline
+	// Workflow is set for workflow and job events.
+	Workflow *Workflow
+	// Job is set for job events.
+	Job *WorkflowJob
+	// PreviousStatus is the status of the workflow or job before the change,
+	// empty when it was not seen before.
+	PreviousStatus string
+	// Cursor resumes the watch right after this event, see
+	// WatchOptions.Cursor.
+	Cursor string
+}
+
+// Watcher streams the events of a watch started by Client.Watch.
+type Watcher struct {
+	events chan *WatchEvent
+
+	mu  sync.Mutex
+	err error
+}
+
+// Events returns the channel events are delivered on. It is closed when the
+// watch ends, after which Err tells why.
+func (w *Watcher) Events() <-chan *WatchEvent {
+	return w.events
+}
+
+// Err returns the error that ended the watch, or nil when it was ended by
+// canceling its context. Watches can be restarted from the cursor of the
+// last event received.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// watchState is the last known state of the watched pipelines. It is what
+// cursors encode.
+type watchState struct {
+	Pipelines map[string]bool   `json:"p"`
+	Workflows map[string]string `json:"w"`
+	// Jobs are keyed by workflow ID and job name, as jobs that haven't
+	// started have no ID.
+	Jobs map[string]string `json:"j"`
+}
+
+func newWatchState() *watchState {
+	return &watchState{
+		Pipelines: map[string]bool{},
+		Workflows: map[string]string{},
+		Jobs:      map[string]string{},
+	}
+}
+
+func decodeWatchCursor(cursor string) (*watchState, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidWatchCursor
+	}
+	s := newWatchState()
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, ErrInvalidWatchCursor
+	}
+	return s, nil
+}
+
+func (s *watchState) cursor() string {
+	b, _ := json.Marshal(s)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Watch polls the recent pipelines of the project and emits an event for
+// each pipeline created and each workflow or job changing status. It fails
+// right away only when options.Cursor is invalid, later errors end the watch
+// and are reported by Watcher.Err.
+func (c *Client) Watch(ctx context.Context, projectSlug string, options WatchOptions) (*Watcher, error) {
+	if !validString(&projectSlug) {
+		return nil, ErrRequiredProjectSlug
+	}
+
+	state := newWatchState()
+	baseline := true
+	if options.Cursor != "" {
+		s, err := decodeWatchCursor(options.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		state, baseline = s, false
+	}
+
+	if options.Interval <= 0 {
+		options.Interval = defaultWatchInterval
+	}
+	if options.MaxPipelines <= 0 {
+		options.MaxPipelines = defaultWatchMaxPipelines
+	}
+
+	w := &Watcher{events: make(chan *WatchEvent)}
+	go func() {
+		defer close(w.events)
+
+		pw := &projectWatch{client: c, projectSlug: projectSlug, options: options, state: state}
+		for {
+			err := pw.poll(ctx, baseline, w.events)
+			if err != nil {
+				if ctx.Err() == nil {
+					w.mu.Lock()
+					w.err = err
+					w.mu.Unlock()
+				}
+				return
+			}
+			baseline = false
+
+			t := time.NewTimer(options.Interval)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+			case <-t.C:
+			}
+		}
+	}()
+
+	return w, nil
+}
+
+type projectWatch struct {
+	client      *Client
+	projectSlug string
+	options     WatchOptions
+	state       *watchState
+}
+
+// poll takes a snapshot of the project, diffs it with the known state and
+// sends the resulting events. A baseline poll only records the state.
+func (pw *projectWatch) poll(ctx context.Context, baseline bool, events chan<- *WatchEvent) error {
+	var pipelines []*Pipeline
+	options := ProjectListPipelinesOptions{Branch: pw.options.Branch}
+	for len(pipelines) < pw.options.MaxPipelines {
+		pl, err := pw.client.Projects.ListPipelines(ctx, pw.projectSlug, options)
+		if err != nil {
+			return err
+		}
+
+		pipelines = append(pipelines, pl.Items...)
+		if pl.NextPageToken == "" {
+			break
+		}
+		options.PageToken = String(pl.NextPageToken)
+	}
+
+	if len(pipelines) > pw.options.MaxPipelines {
+		pipelines = pipelines[:pw.options.MaxPipelines]
+	}
+
+	next := newWatchState()
+	emit := func(e *WatchEvent) error {
+		if baseline || !pw.wants(e.Type) {
+			return nil
+		}
+		e.Time = time.Now()
+		e.Cursor = pw.state.cursor()
+		select {
+		case events <- e:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// Pipelines are listed newest first, emit in the order things happened.
+	for i := len(pipelines) - 1; i >= 0; i-- {
+		p := pipelines[i]
+		next.Pipelines[p.ID] = true
+		if !pw.state.Pipelines[p.ID] {
+			pw.state.Pipelines[p.ID] = true
+			if err := emit(&WatchEvent{Type: WatchEventPipelineCreated, Pipeline: p}); err != nil {
+				return err
+			}
+		}
+
+		if err := pw.pollWorkflows(ctx, p, next, emit); err != nil {
+			return err
+		}
+	}
+
+	// Forget the pipelines that fell out of the window.
+	pw.state = next
+	return nil
+}
+
+func (pw *projectWatch) pollWorkflows(ctx context.Context, p *Pipeline, next *watchState, emit func(*WatchEvent) error) error {
+	options := PipelineListWorkflowsOptions{}
+	for {
+		wl, err := pw.client.Pipelines.ListWorkflows(ctx, p.ID, options)
+		if err != nil {
+			return err
+		}
+
+		for _, wf := range wl.Items {
+			status := workflowStatus(wf)
+			prev, known := pw.state.Workflows[wf.ID]
+			next.Workflows[wf.ID] = status
+
+			if prev != status {
+				pw.state.Workflows[wf.ID] = status
+				if err := emit(&WatchEvent{Type: WatchEventWorkflowStatusChanged, Pipeline: p, Workflow: wf, PreviousStatus: prev}); err != nil {
+					return err
+				}
+			}
+
+			// The jobs of a workflow that was already done can't change.
+			if known && prev == status && finalWorkflowStatuses[status] {
+				pw.keepJobs(wf.ID, next)
+				continue
+			}
+
+			if err := pw.pollJobs(ctx, p, wf, next, emit); err != nil {
+				return err
+			}
+		}
+
+		if wl.NextPageToken == "" {
+			return nil
+		}
+		options.PageToken = String(wl.NextPageToken)
+	}
+}
+
+func (pw *projectWatch) pollJobs(ctx context.Context, p *Pipeline, wf *Workflow, next *watchState, emit func(*WatchEvent) error) error {
+	jl, err := pw.client.Workflows.ListWorkflowJobs(ctx, wf.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, j := range jl.Items {
+		key := wf.ID + "/" + j.Name
+		prev := pw.state.Jobs[key]
+		next.Jobs[key] = j.Status
+		if prev == j.Status {
+			continue
+		}
+		pw.state.Jobs[key] = j.Status
+
+		var typ WatchEventType
+		switch {
+		case j.Type == "approval" && j.Status == "on_hold":
+			typ = WatchEventApprovalPending
+		case j.Status == "running":
+			typ = WatchEventJobStarted
+		case finishedJobStatuses[j.Status]:
+			typ = WatchEventJobFinished
+		default:
+			continue
+		}
+
+		if err := emit(&WatchEvent{Type: typ, Pipeline: p, Workflow: wf, Job: j, PreviousStatus: prev}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// keepJobs carries the known job statuses of a workflow over to next.
+func (pw *projectWatch) keepJobs(workflowID string, next *watchState) {
+	prefix := workflowID + "/"
+	for k, v := range pw.state.Jobs {
+		if len(k) > len(prefix) && k[:len(prefix)] == prefix {
+			next.Jobs[k] = v
+		}
+	}
+}
+
+func (pw *projectWatch) wants(typ WatchEventType) bool {
+	if len(pw.options.Types) == 0 {
+		return true
+	}
+	for _, t := range pw.options.Types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
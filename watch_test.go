@@ -0,0 +1,217 @@
+package circleci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// watchMux serves a project whose second poll finishes pipeline1 and adds
+// pipeline2. polls is the number of polls already made.
+func watchMux(mux *http.ServeMux, projectSlug string, polls int32) {
+
+	mux.HandleFunc(fmt.Sprintf("/project/%s/pipeline", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&polls, 1) == 1 {
+			fmt.Fprint(w, `{"items": [{"id": "pipeline1", "number": 1}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"items": [{"id": "pipeline2", "number": 2}, {"id": "pipeline1", "number": 1}]}`)
+	})
+	mux.HandleFunc("/pipeline/pipeline1/workflow", func(w http.ResponseWriter, r *http.Request) {
+		status := "success"
+		if atomic.LoadInt32(&polls) == 1 {
+			status = "running"
+		}
+		fmt.Fprintf(w, `{"items": [{"id": "workflow1", "name": "build", "status": "%s"}]}`, status)
+	})
+	mux.HandleFunc("/workflow/workflow1/job", func(w http.ResponseWriter, r *http.Request) {
+		status := "success"
+		if atomic.LoadInt32(&polls) == 1 {
+			status = "running"
+		}
+		fmt.Fprintf(w, `{"items": [{"id": "job1", "name": "test", "type": "build", "status": "%s"}]}`, status)
+	})
+	mux.HandleFunc("/pipeline/pipeline2/workflow", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": [{"id": "workflow2", "name": "deploy", "status": "on_hold"}]}`)
+	})
+	mux.HandleFunc("/workflow/workflow2/job", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": [{"id": "job2", "name": "hold", "type": "approval", "status": "on_hold"}, {"name": "release", "type": "build", "status": "blocked"}]}`)
+	})
+}
+
+type watchRecord struct {
+	Type     WatchEventType
+	Pipeline string
+	Workflow string
+	Job      string
+	Previous string
+}
+
+func collectWatchEvents(t *testing.T, w *Watcher, n int) ([]watchRecord, []*WatchEvent) {
+	t.Helper()
+	var (
+		records []watchRecord
+		events  []*WatchEvent
+	)
+	timeout := time.After(5 * time.Second)
+	for len(events) < n {
+		select {
+		case e, ok := <-w.Events():
+			if !ok {
+				t.Fatalf("watch ended early: %v", w.Err())
+			}
+			r := watchRecord{Type: e.Type, Pipeline: e.Pipeline.ID, Previous: e.PreviousStatus}
+			if e.Workflow != nil {
+				r.Workflow = e.Workflow.Name
+			}
+			if e.Job != nil {
+				r.Job = e.Job.Name
+			}
+			records = append(records, r)
+			events = append(events, e)
+		case <-timeout:
+			t.Fatalf("got %d events, want %d", len(events), n)
+		}
+	}
+	return records, events
+}
+
+func Test_Client_Watch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+	watchMux(mux, projectSlug, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w, err := client.Watch(ctx, projectSlug, WatchOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Client.Watch got error: %v", err)
+	}
+
+	got, events := collectWatchEvents(t, w, 5)
+
+	want := []watchRecord{
+		{Type: WatchEventWorkflowStatusChanged, Pipeline: "pipeline1", Workflow: "build", Previous: "running"},
+		{Type: WatchEventJobFinished, Pipeline: "pipeline1", Workflow: "build", Job: "test", Previous: "running"},
+		{Type: WatchEventPipelineCreated, Pipeline: "pipeline2"},
+		{Type: WatchEventWorkflowStatusChanged, Pipeline: "pipeline2", Workflow: "deploy"},
+		{Type: WatchEventApprovalPending, Pipeline: "pipeline2", Workflow: "deploy", Job: "hold"},
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("Client.Watch got diff (-got +want):\n%s", cmp.Diff(got, want))
+	}
+
+	cancel()
+	for range w.Events() {
+	}
+	if err := w.Err(); err != nil {
+		t.Errorf("Watcher.Err got %v, want nil after cancel", err)
+	}
+
+	// Resuming from the cursor of the second event delivers the rest again.
+	client2, mux2, _, teardown2 := setup()
+	defer teardown2()
+	watchMux(mux2, projectSlug, 1)
+
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+
+	w2, err := client2.Watch(ctx2, projectSlug, WatchOptions{
+		Interval: time.Millisecond,
+		Cursor:   events[1].Cursor,
+		Types:    []WatchEventType{WatchEventPipelineCreated, WatchEventApprovalPending},
+	})
+	if err != nil {
+		t.Fatalf("Client.Watch got error: %v", err)
+	}
+
+	got, _ = collectWatchEvents(t, w2, 2)
+	want = []watchRecord{
+		{Type: WatchEventPipelineCreated, Pipeline: "pipeline2"},
+		{Type: WatchEventApprovalPending, Pipeline: "pipeline2", Workflow: "deploy", Job: "hold"},
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("Client.Watch resumed with diff (-got +want):\n%s", cmp.Diff(got, want))
+	}
+}
+
+func Test_Client_Watch_invalidCursor(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, err := client.Watch(context.Background(), "gh/org1/prj1", WatchOptions{Cursor: "!!"})
+	if err != ErrInvalidWatchCursor {
+		t.Errorf("Client.Watch got error %v, want %v", err, ErrInvalidWatchCursor)
+	}
+}
+
+func Test_Client_Watch_pages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	projectSlug := "gh/org1/prj1"
+	var polls int32
+
+	mux.HandleFunc(fmt.Sprintf("/project/%s/pipeline", projectSlug), func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page-token") {
+		case "":
+			if atomic.AddInt32(&polls, 1) == 1 {
+				fmt.Fprint(w, `{"items": [{"id": "pipeline2"}], "next_page_token": "page2"}`)
+				return
+			}
+			fmt.Fprint(w, `{"items": [{"id": "pipeline3"}], "next_page_token": "page2"}`)
+		case "page2":
+			fmt.Fprint(w, `{"items": [{"id": "pipeline1"}, {"id": "pipeline0"}], "next_page_token": "page3"}`)
+		default:
+			t.Errorf("Client.Watch read page %q past MaxPipelines", r.URL.Query().Get("page-token"))
+			fmt.Fprint(w, `{"items": []}`)
+		}
+	})
+	mux.HandleFunc("/pipeline/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": []}`)
+	})
+	mux.HandleFunc("/workflow/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": []}`)
+	})
+	// pipeline1 is only on the second page, and finishes after the first
+	// poll.
+	mux.HandleFunc("/pipeline/pipeline1/workflow", func(w http.ResponseWriter, r *http.Request) {
+		status := "success"
+		if atomic.LoadInt32(&polls) == 1 {
+			status = "running"
+		}
+		fmt.Fprintf(w, `{"items": [{"id": "workflow1", "name": "build", "status": "%s"}]}`, status)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w, err := client.Watch(ctx, projectSlug, WatchOptions{
+		Interval:     time.Millisecond,
+		MaxPipelines: 3,
+		Types:        []WatchEventType{WatchEventPipelineCreated, WatchEventWorkflowStatusChanged},
+	})
+	if err != nil {
+		t.Fatalf("Client.Watch got error: %v", err)
+	}
+
+	got, _ := collectWatchEvents(t, w, 2)
+	want := []watchRecord{
+		{Type: WatchEventWorkflowStatusChanged, Pipeline: "pipeline1", Workflow: "build", Previous: "running"},
+		{Type: WatchEventPipelineCreated, Pipeline: "pipeline3"},
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("Client.Watch got diff (-got +want):\n%s", cmp.Diff(got, want))
+	}
+}
@@ -0,0 +1,119 @@
+// Package webhook receives the outbound webhooks CircleCI sends to the
+// endpoints registered with circleci.Webhooks.
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/grezar/go-circleci"
+)
+
+var ErrUnknownEventType = errors.New("webhook: unknown event type")
+
+// Event is either a *WorkflowCompletedEvent or a *JobCompletedEvent.
+type Event interface {
+	EventID() string
+	EventType() circleci.Event
+	EventTime() time.Time
+}
+
+// Payload holds the fields common to every event. Deliveries carry fewer
+// fields than the API returns for the same resources, so the fields they
+// omit are left zero.
+type Payload struct {
+	ID           string                       `json:"id"`
+	Type         circleci.Event               `json:"type"`
+	HappenedAt   time.Time                    `json:"happened_at"`
+	Webhook      *WebhookInfo                 `json:"webhook"`
+	Project      *circleci.Project            `json:"project"`
+	Organization *circleci.OrganizationDetail `json:"organization"`
+	Pipeline     *Pipeline                    `json:"pipeline"`
+	Workflow     *Workflow                    `json:"workflow"`
+}
+
+func (p *Payload) EventID() string           { return p.ID }
+func (p *Payload) EventType() circleci.Event { return p.Type }
+func (p *Payload) EventTime() time.Time      { return p.HappenedAt }
+
+// WebhookInfo identifies the webhook that sent an event.
+type WebhookInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Pipeline struct {
+	circleci.Pipeline
+	TriggerParameters map[string]interface{} `json:"trigger_parameters,omitempty"`
+	// Vcs shadows the one of circleci.Pipeline to add the commit author.
+	Vcs *VCS `json:"vcs,omitempty"`
+}
+
+type VCS struct {
+	circleci.VCS
+	Commit *Commit `json:"commit,omitempty"`
+}
+
+type Commit struct {
+	circleci.Commit
+	Author      *Person   `json:"author"`
+	AuthoredAt  time.Time `json:"authored_at"`
+	Committer   *Person   `json:"committer"`
+	CommittedAt time.Time `json:"committed_at"`
+}
+
+type Person struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type Workflow struct {
+	circleci.Workflow
+	// StoppedAt shadows the one of circleci.Workflow. It is nil while the
+	// workflow runs, as it may when one of its jobs completes.
+	StoppedAt *time.Time `json:"stopped_at"`
+	URL       string     `json:"url"`
+}
+
+type Job struct {
+	circleci.Job
+	ID string `json:"id"`
+}
+
+type WorkflowCompletedEvent struct {
+	Payload
+}
+
+type JobCompletedEvent struct {
+	Payload
+	Job *Job `json:"job"`
+}
+
+// ParseEvent decodes an event payload. It does not verify its signature,
+// see VerifySignature.
+func ParseEvent(body []byte) (Event, error) {
+	var p struct {
+		Type circleci.Event `json:"type"`
+	}
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, err
+	}
+
+	var e Event
+	switch p.Type {
+	case circleci.EventWorkflowCompleted:
+		e = &WorkflowCompletedEvent{}
+	case circleci.EventJobCompleted:
+		e = &JobCompletedEvent{}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEventType, p.Type)
+	}
+
+	if err := json.Unmarshal(body, e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grezar/go-circleci"
+)
+
+const jobCompletedPayload = `{
+  "id": "event1",
+  "type": "job-completed",
+  "happened_at": "2023-01-02T03:04:05Z",
+  "webhook": {"id": "webhook1", "name": "notify"},
+  "project": {"id": "project1", "name": "prj1", "slug": "gh/org1/prj1"},
+  "organization": {"id": "org1", "name": "org1"},
+  "pipeline": {
+    "id": "pipeline1",
+    "number": 42,
+    "created_at": "2023-01-02T03:00:00Z",
+    "trigger": {"type": "webhook"},
+    "trigger_parameters": {"deploy": true},
+    "vcs": {
+      "provider_name": "github",
+      "origin_repository_url": "https://github.com/org1/prj1",
+      "target_repository_url": "https://github.com/org1/prj1",
+      "revision": "abc",
+      "commit": {
+        "subject": "Fix tests",
+        "body": "",
+        "author": {"name": "Author", "email": "author@example.com"},
+        "authored_at": "2023-01-02T02:59:00Z",
+        "committer": {"name": "Committer", "email": "committer@example.com"},
+        "committed_at": "2023-01-02T02:59:30Z"
+      },
+      "branch": "main"
+    }
+  },
+  "workflow": {
+    "id": "workflow1",
+    "name": "build",
+    "status": "failed",
+    "created_at": "2023-01-02T03:00:01Z",
+    "stopped_at": "2023-01-02T03:04:04Z",
+    "url": "https://app.circleci.com/pipelines/workflows/workflow1"
+  },
+  "job": {
+    "id": "job1",
+    "name": "test",
+    "number": 7,
+    "status": "failed",
+    "started_at": "2023-01-02T03:01:00Z",
+    "stopped_at": "2023-01-02T03:04:00Z"
+  }
+}`
+
+func TestParseEvent(t *testing.T) {
+	e, err := ParseEvent([]byte(jobCompletedPayload))
+	if err != nil {
+		t.Fatalf("ParseEvent got error: %v", err)
+	}
+
+	je, ok := e.(*JobCompletedEvent)
+	if !ok {
+		t.Fatalf("ParseEvent got %T, want *JobCompletedEvent", e)
+	}
+
+	at := func(hour, min, sec int) time.Time {
+		return time.Date(2023, 1, 2, hour, min, sec, 0, time.UTC)
+	}
+	want := &JobCompletedEvent{
+		Payload: Payload{
+			ID:           "event1",
+			Type:         circleci.EventJobCompleted,
+			HappenedAt:   at(3, 4, 5),
+			Webhook:      &WebhookInfo{ID: "webhook1", Name: "notify"},
+			Project:      &circleci.Project{ID: "project1", Name: "prj1", Slug: "gh/org1/prj1"},
+			Organization: &circleci.OrganizationDetail{ID: "org1", Name: "org1"},
+			Pipeline: &Pipeline{
+				Pipeline: circleci.Pipeline{
+					ID:        "pipeline1",
+					Number:    42,
+					CreatedAt: at(3, 0, 0),
+					Trigger:   &circleci.Trigger{Type: "webhook"},
+				},
+				TriggerParameters: map[string]interface{}{"deploy": true},
+				Vcs: &VCS{
+					VCS: circleci.VCS{
+						ProviderName:        "github",
+						OriginRepositoryURL: "https://github.com/org1/prj1",
+						TargetRepositoryURL: "https://github.com/org1/prj1",
+						Revision:            "abc",
+						Branch:              "main",
+					},
+					Commit: &Commit{
+						Commit:      circleci.Commit{Subject: "Fix tests"},
+						Author:      &Person{Name: "Author", Email: "author@example.com"},
+						AuthoredAt:  at(2, 59, 0),
+						Committer:   &Person{Name: "Committer", Email: "committer@example.com"},
+						CommittedAt: at(2, 59, 30),
+					},
+				},
+			},
+			Workflow: &Workflow{
+				Workflow: circleci.Workflow{
+					ID:        "workflow1",
+					Name:      "build",
+					Status:    "failed",
+					CreatedAt: at(3, 0, 1),
+				},
+				StoppedAt: timePtr(at(3, 4, 4)),
+				URL:       "https://app.circleci.com/pipelines/workflows/workflow1",
+			},
+		},
+		Job: &Job{
+			Job: circleci.Job{
+				Name:      "test",
+				Number:    7,
+				Status:    "failed",
+				StartedAt: at(3, 1, 0),
+				StoppedAt: at(3, 4, 0),
+			},
+			ID: "job1",
+		},
+	}
+
+	if !cmp.Equal(je, want) {
+		t.Errorf("ParseEvent got diff (-got +want):\n%s", cmp.Diff(je, want))
+	}
+
+	if e.EventType() != circleci.EventJobCompleted || e.EventID() != "event1" {
+		t.Errorf("Event got type %q and ID %q", e.EventType(), e.EventID())
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestParseEvent_workflowCompleted(t *testing.T) {
+	e, err := ParseEvent([]byte(`{"id": "event2", "type": "workflow-completed", "workflow": {"id": "workflow1", "status": "success"}}`))
+	if err != nil {
+		t.Fatalf("ParseEvent got error: %v", err)
+	}
+
+	we, ok := e.(*WorkflowCompletedEvent)
+	if !ok {
+		t.Fatalf("ParseEvent got %T, want *WorkflowCompletedEvent", e)
+	}
+	if we.Workflow.Status != "success" {
+		t.Errorf("WorkflowCompletedEvent got workflow status %v, want success", we.Workflow.Status)
+	}
+}
+
+func TestParseEvent_unknownType(t *testing.T) {
+	_, err := ParseEvent([]byte(`{"type": "ping"}`))
+	if !errors.Is(err, ErrUnknownEventType) {
+		t.Errorf("ParseEvent got error %v, want %v", err, ErrUnknownEventType)
+	}
+}
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultTolerance is how old an event may be when it is received.
+	DefaultTolerance = 5 * time.Minute
+	// DefaultMaxBodySize is the largest payload accepted.
+	DefaultMaxBodySize = 1 << 20
+)
+
+var (
+	ErrRequiredSecrets     = errors.New("webhook: at least one signing secret is required")
+	ErrRequiredHandlerFunc = errors.New("webhook: handler func is required")
+	ErrEventTooOld         = errors.New("webhook: event is outside the timestamp tolerance")
+)
+
+// HandlerFunc processes a verified event. A returned error makes the handler
+// answer with a 500, which makes CircleCI consider the delivery failed.
+type HandlerFunc func(ctx context.Context, e Event) error
+
+type HandlerOptions struct {
+	// Secrets are the signing secrets of the webhook. An event is accepted
+	// when signed with any of them, which allows rotating secrets.
+	Secrets []string
+	// Tolerance is how far the time an event happened may be from the time
+	// it is received, to limit replays. It defaults to DefaultTolerance and
+	// a negative value disables the check.
+	Tolerance time.Duration
+	// MaxBodySize defaults to DefaultMaxBodySize.
+	MaxBodySize int64
+}
+
+// Handler is an http.Handler receiving CircleCI webhooks. It only passes on
+// events that are correctly signed and recent enough.
+type Handler struct {
+	secrets     []string
+	tolerance   time.Duration
+	maxBodySize int64
+	fn          HandlerFunc
+}
+
+var now = time.Now
+
+func NewHandler(options HandlerOptions, fn HandlerFunc) (*Handler, error) {
+	secrets := make([]string, 0, len(options.Secrets))
+	for _, s := range options.Secrets {
+		if s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+	if len(secrets) == 0 {
+		return nil, ErrRequiredSecrets
+	}
+
+	if fn == nil {
+		return nil, ErrRequiredHandlerFunc
+	}
+
+	h := &Handler{
+		secrets:     secrets,
+		tolerance:   options.Tolerance,
+		maxBodySize: options.MaxBodySize,
+		fn:          fn,
+	}
+	if h.tolerance == 0 {
+		h.tolerance = DefaultTolerance
+	}
+	if h.maxBodySize <= 0 {
+		h.maxBodySize = DefaultMaxBodySize
+	}
+
+	return h, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, h.maxBodySize+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > h.maxBodySize {
+		http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	e, err := h.verify(body, r.Header.Get(SignatureHeader))
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrMissingSignature) || errors.Is(err, ErrInvalidSignature) {
+			status = http.StatusUnauthorized
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if err := h.fn(r.Context(), e); err != nil {
+		http.Error(w, "failed to process event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verify checks the signature and age of the payload and decodes it.
+func (h *Handler) verify(body []byte, signature string) (Event, error) {
+	if err := VerifySignature(body, signature, h.secrets...); err != nil {
+		return nil, err
+	}
+
+	e, err := ParseEvent(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.tolerance > 0 {
+		d := now().Sub(e.EventTime())
+		if d < 0 {
+			d = -d
+		}
+		if d > h.tolerance {
+			return nil, ErrEventTooOld
+		}
+	}
+
+	return e, nil
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestRequest(method, body, signature string) *http.Request {
+	r := httptest.NewRequest(method, "/webhook", strings.NewReader(body))
+	if signature != "" {
+		r.Header.Set(SignatureHeader, signature)
+	}
+	return r
+}
+
+func TestHandler(t *testing.T) {
+	defer func() { now = time.Now }()
+	now = func() time.Time { return time.Date(2023, 1, 2, 3, 5, 0, 0, time.UTC) }
+
+	var received []Event
+	h, err := NewHandler(HandlerOptions{
+		Secrets:     []string{"old", "new"},
+		MaxBodySize: 2048,
+	}, func(ctx context.Context, e Event) error {
+		received = append(received, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewHandler got error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		secret string
+		want   int
+	}{
+		{"valid", "POST", jobCompletedPayload, "new", http.StatusNoContent},
+		{"old secret", "POST", jobCompletedPayload, "old", http.StatusNoContent},
+		{"wrong secret", "POST", jobCompletedPayload, "other", http.StatusUnauthorized},
+		{"unsigned", "POST", jobCompletedPayload, "", http.StatusUnauthorized},
+		{"too old", "POST", strings.Replace(jobCompletedPayload, "2023-01-02T03:04:05Z", "2023-01-02T02:00:00Z", 1), "new", http.StatusBadRequest},
+		{"too large", "POST", jobCompletedPayload + strings.Repeat(" ", 2048), "new", http.StatusRequestEntityTooLarge},
+		{"unknown type", "POST", `{"type": "ping"}`, "new", http.StatusBadRequest},
+		{"wrong method", "GET", "", "", http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var signature string
+			if tt.secret != "" {
+				signature = Sign([]byte(tt.body), tt.secret)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, newTestRequest(tt.method, tt.body, signature))
+			if w.Code != tt.want {
+				t.Errorf("Handler got status %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+
+	if len(received) != 2 {
+		t.Errorf("Handler passed on %d events, want 2", len(received))
+	}
+}
+
+func TestHandler_funcError(t *testing.T) {
+	h, err := NewHandler(HandlerOptions{
+		Secrets:   []string{"secret"},
+		Tolerance: -1,
+	}, func(ctx context.Context, e Event) error {
+		return errors.New("boom")
+	})
+	if err != nil {
+		t.Fatalf("NewHandler got error: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newTestRequest("POST", jobCompletedPayload, Sign([]byte(jobCompletedPayload), "secret")))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Handler got status %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestNewHandler_errors(t *testing.T) {
+	fn := func(ctx context.Context, e Event) error { return nil }
+
+	if _, err := NewHandler(HandlerOptions{Secrets: []string{""}}, fn); err != ErrRequiredSecrets {
+		t.Errorf("NewHandler got error %v, want %v", err, ErrRequiredSecrets)
+	}
+	if _, err := NewHandler(HandlerOptions{Secrets: []string{"secret"}}, nil); err != ErrRequiredHandlerFunc {
+		t.Errorf("NewHandler got error %v, want %v", err, ErrRequiredHandlerFunc)
+	}
+}
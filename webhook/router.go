@@ -0,0 +1,174 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/grezar/go-circleci"
+)
+
+// Filter selects the events a handler is subscribed to. Every field except
+// Types is a path.Match pattern, except that * also matches across /, so
+// that feature/* matches feature/x/y. Empty fields match anything.
+type Filter struct {
+	Types []circleci.Event
+	// Project matches the project slug, e.g. gh/org/*.
+	Project string
+	Branch  string
+	// Status matches the job status of job-completed events and the
+	// workflow status of workflow-completed events.
+	Status   string
+	Workflow string
+}
+
+func (f Filter) match(e Event) bool {
+	if len(f.Types) > 0 {
+		found := false
+		for _, t := range f.Types {
+			if t == e.EventType() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	var p *Payload
+	var status interface{}
+	switch e := e.(type) {
+	case *WorkflowCompletedEvent:
+		p = &e.Payload
+		if e.Workflow != nil {
+			status = e.Workflow.Status
+		}
+	case *JobCompletedEvent:
+		p = &e.Payload
+		if e.Job != nil {
+			status = e.Job.Status
+		}
+	default:
+		return false
+	}
+
+	var project, branch, workflow string
+	if p.Project != nil {
+		project = p.Project.Slug
+	}
+	if p.Pipeline != nil && p.Pipeline.Vcs != nil {
+		branch = p.Pipeline.Vcs.Branch
+	}
+	if p.Workflow != nil {
+		workflow = p.Workflow.Name
+	}
+
+	return matchPattern(f.Project, project) &&
+		matchPattern(f.Branch, branch) &&
+		matchPattern(f.Status, statusString(status)) &&
+		matchPattern(f.Workflow, workflow)
+}
+
+func matchPattern(pattern, s string) bool {
+	if pattern == "" {
+		return true
+	}
+	// Branches and slugs are not paths, so / is swapped for a byte that
+	// path.Match gives no special meaning.
+	ok, _ := path.Match(strings.ReplaceAll(pattern, "/", "\x00"), strings.ReplaceAll(s, "/", "\x00"))
+	return ok
+}
+
+func statusString(status interface{}) string {
+	if status == nil {
+		return ""
+	}
+	return fmt.Sprint(status)
+}
+
+type route struct {
+	filter Filter
+	fn     HandlerFunc
+}
+
+// Router dispatches events to the handlers subscribed to them. Its Dispatch
+// method can be passed to NewHandler.
+//
+// When a Store is set, events are recorded once every handler succeeded and
+// redeliveries of recorded events are dropped. Handlers should still be
+// idempotent, as an event delivered twice concurrently reaches them twice.
+type Router struct {
+	store  Store
+	routes []*route
+}
+
+// NewRouter returns a Router recording events in store, which may be nil.
+func NewRouter(store Store) *Router {
+	return &Router{store: store}
+}
+
+// Handle subscribes fn to the events matching filter. It must not be called
+// concurrently with Dispatch.
+func (r *Router) Handle(filter Filter, fn HandlerFunc) {
+	r.routes = append(r.routes, &route{filter: filter, fn: fn})
+}
+
+func (r *Router) Dispatch(ctx context.Context, e Event) error {
+	if r.store != nil {
+		ok, err := r.store.Has(ctx, e.EventID())
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	if err := r.dispatch(ctx, e); err != nil {
+		return err
+	}
+
+	if r.store != nil {
+		return r.store.Put(ctx, e)
+	}
+
+	return nil
+}
+
+// Replay dispatches again the stored events that happened at or after since,
+// oldest first, for example to catch up a handler after an outage.
+func (r *Router) Replay(ctx context.Context, since time.Time) error {
+	if r.store == nil {
+		return nil
+	}
+
+	es, err := r.store.Since(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range es {
+		if err := r.dispatch(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dispatch runs every matching handler and returns the first error.
+func (r *Router) dispatch(ctx context.Context, e Event) error {
+	var first error
+	for _, rt := range r.routes {
+		if !rt.filter.match(e) {
+			continue
+		}
+		if err := rt.fn(ctx, e); err != nil && first == nil {
+			first = fmt.Errorf("webhook: handling event %s: %w", e.EventID(), err)
+		}
+	}
+	return first
+}
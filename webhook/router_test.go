@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grezar/go-circleci"
+)
+
+func TestFilter(t *testing.T) {
+	e, err := ParseEvent([]byte(jobCompletedPayload))
+	if err != nil {
+		t.Fatalf("ParseEvent got error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty", Filter{}, true},
+		{"type", Filter{Types: []circleci.Event{circleci.EventJobCompleted}}, true},
+		{"other type", Filter{Types: []circleci.Event{circleci.EventWorkflowCompleted}}, false},
+		{"project pattern", Filter{Project: "gh/org1/*"}, true},
+		{"other project", Filter{Project: "gh/org2/*"}, false},
+		{"branch", Filter{Branch: "main"}, true},
+		{"other branch", Filter{Branch: "release/*"}, false},
+		{"job status", Filter{Status: "failed"}, true},
+		{"other status", Filter{Status: "success"}, false},
+		{"workflow", Filter{Workflow: "b*"}, true},
+		{"all", Filter{Project: "gh/org1/prj1", Branch: "main", Status: "failed", Workflow: "build"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.match(e); got != tt.want {
+				t.Errorf("match got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter_nestedBranch(t *testing.T) {
+	e, err := ParseEvent([]byte(strings.Replace(jobCompletedPayload, `"branch": "main"`, `"branch": "feature/x/y"`, 1)))
+	if err != nil {
+		t.Fatalf("ParseEvent got error: %v", err)
+	}
+
+	tests := []struct {
+		pattern string
+		want    bool
+	}{
+		{"*", true},
+		{"feature/*", true},
+		{"feature/x/*", true},
+		{"feature/?/y", true},
+		{"feature", false},
+		{"release/*", false},
+	}
+
+	for _, tt := range tests {
+		if got := (Filter{Branch: tt.pattern}).match(e); got != tt.want {
+			t.Errorf("match of branch pattern %q got %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestRouter(t *testing.T) {
+	ctx := context.Background()
+	t0 := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	store := NewMemoryStore()
+	r := NewRouter(store)
+
+	var all, failed []string
+	fail := true
+	r.Handle(Filter{}, func(ctx context.Context, e Event) error {
+		all = append(all, e.EventID())
+		return nil
+	})
+	r.Handle(Filter{Status: "failed"}, func(ctx context.Context, e Event) error {
+		failed = append(failed, e.EventID())
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	failedEvent := testEvent("event2", t0.Add(time.Minute))
+	failedEvent.Workflow.Status = "failed"
+
+	if err := r.Dispatch(ctx, testEvent("event1", t0)); err != nil {
+		t.Fatalf("Dispatch got error: %v", err)
+	}
+	if err := r.Dispatch(ctx, failedEvent); err == nil {
+		t.Fatalf("Dispatch got no error, want one")
+	}
+
+	// The failed event is not recorded, so its redelivery is handled again,
+	// while the redelivery of the first one is dropped.
+	fail = false
+	if err := r.Dispatch(ctx, failedEvent); err != nil {
+		t.Fatalf("Dispatch got error: %v", err)
+	}
+	if err := r.Dispatch(ctx, testEvent("event1", t0)); err != nil {
+		t.Fatalf("Dispatch got error: %v", err)
+	}
+
+	if got, want := len(all), 3; got != want {
+		t.Errorf("handler got %d events %v, want %d", got, all, want)
+	}
+	if got, want := len(failed), 2; got != want {
+		t.Errorf("failed handler got %d events %v, want %d", got, failed, want)
+	}
+
+	all, failed = nil, nil
+	if err := r.Replay(ctx, t0.Add(time.Second)); err != nil {
+		t.Fatalf("Replay got error: %v", err)
+	}
+	if len(all) != 1 || all[0] != "event2" || len(failed) != 1 {
+		t.Errorf("Replay got events %v and %v, want event2", all, failed)
+	}
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// SignatureHeader is the header CircleCI signs payloads in.
+const SignatureHeader = "circleci-signature"
+
+// signatureVersion is the only signature scheme CircleCI uses so far, an
+// HMAC-SHA256 of the body.
+const signatureVersion = "v1"
+
+var (
+	ErrMissingSignature = errors.New("webhook: missing signature")
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+)
+
+// Sign returns the signature header value of body for secret.
+func Sign(body []byte, secret string) string {
+	return signatureVersion + "=" + hex.EncodeToString(mac(body, secret))
+}
+
+// VerifySignature checks the value of the circleci-signature header against
+// body. The header may carry several comma separated signatures, of which
+// the v1 ones are checked. It succeeds when any of them matches any of the
+// secrets, so that secrets can be rotated.
+func VerifySignature(body []byte, header string, secrets ...string) error {
+	var sigs [][]byte
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 || kv[0] != signatureVersion {
+			continue
+		}
+		b, err := hex.DecodeString(kv[1])
+		if err != nil {
+			continue
+		}
+		sigs = append(sigs, b)
+	}
+
+	if len(sigs) == 0 {
+		return ErrMissingSignature
+	}
+
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		expected := mac(body, secret)
+		for _, sig := range sigs {
+			if hmac.Equal(sig, expected) {
+				return nil
+			}
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func mac(body []byte, secret string) []byte {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write(body)
+	return m.Sum(nil)
+}
@@ -0,0 +1,30 @@
+package webhook
+
+import "testing"
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"type": "job-completed"}`)
+
+	tests := []struct {
+		name    string
+		header  string
+		secrets []string
+		want    error
+	}{
+		{"valid", Sign(body, "secret1"), []string{"secret1"}, nil},
+		{"rotated secret", Sign(body, "secret2"), []string{"secret1", "secret2"}, nil},
+		{"several signatures", "v0=abc, " + Sign(body, "secret1"), []string{"secret1"}, nil},
+		{"wrong secret", Sign(body, "secret1"), []string{"secret2"}, ErrInvalidSignature},
+		{"tampered body", Sign([]byte("{}"), "secret1"), []string{"secret1"}, ErrInvalidSignature},
+		{"missing", "", []string{"secret1"}, ErrMissingSignature},
+		{"not hex", "v1=zz", []string{"secret1"}, ErrMissingSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(body, tt.header, tt.secrets...); got != tt.want {
+				t.Errorf("VerifySignature got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Store records the events that were handled, so that redeliveries can be
+// recognised and history can be replayed.
+type Store interface {
+	// Has reports whether an event with the given ID was recorded.
+	Has(ctx context.Context, id string) (bool, error)
+	// Put records e. Recording an event that is already stored is a no-op.
+	Put(ctx context.Context, e Event) error
+	// Since returns the events that happened at or after t, oldest first.
+	Since(ctx context.Context, t time.Time) ([]Event, error)
+}
+
+// MemoryStore is a Store keeping events in memory.
+type MemoryStore struct {
+	mu     sync.Mutex
+	ids    map[string]bool
+	events []Event
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{ids: make(map[string]bool)}
+}
+
+func (s *MemoryStore) Has(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ids[id], nil
+}
+
+func (s *MemoryStore) Put(ctx context.Context, e Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(e)
+	return nil
+}
+
+func (s *MemoryStore) put(e Event) {
+	if s.ids[e.EventID()] {
+		return
+	}
+	s.ids[e.EventID()] = true
+	s.events = append(s.events, e)
+}
+
+func (s *MemoryStore) Since(ctx context.Context, t time.Time) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var es []Event
+	for _, e := range s.events {
+		if !e.EventTime().Before(t) {
+			es = append(es, e)
+		}
+	}
+
+	// Events are stored in the order they were received, which is not
+	// necessarily the order they happened in.
+	sort.SliceStable(es, func(i, j int) bool {
+		return es[i].EventTime().Before(es[j].EventTime())
+	})
+
+	return es, nil
+}
+
+// FileStore is a Store appending events to a file as newline delimited JSON.
+// The file is read once when opened and the events are also kept in memory.
+type FileStore struct {
+	MemoryStore
+	f *os.File
+}
+
+// OpenFileStore opens the store at name, creating the file if needed. A last
+// line left incomplete by a crash is dropped, while any other line that
+// can't be read makes opening fail.
+func OpenFileStore(name string) (*FileStore, error) {
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileStore{MemoryStore: MemoryStore{ids: make(map[string]bool)}, f: f}
+	if err := s.load(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *FileStore) load() error {
+	r := bufio.NewReader(s.f)
+	var offset int64
+	for n := 1; ; n++ {
+		line, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		last := err == io.EOF
+		if !last {
+			_, err := r.Peek(1)
+			last = err == io.EOF
+		}
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			e, perr := ParseEvent(line)
+			switch {
+			case perr != nil && last:
+				return s.f.Truncate(offset)
+			case perr != nil:
+				return fmt.Errorf("%s:%d: %v", s.f.Name(), n, perr)
+			}
+			s.put(e)
+
+			// Terminate a complete last line that lost its newline so that
+			// the next event is appended on a line of its own.
+			if line[len(line)-1] != '\n' {
+				if _, err := s.f.Write([]byte{'\n'}); err != nil {
+					return err
+				}
+			}
+		}
+
+		if last {
+			return nil
+		}
+		offset += int64(len(line))
+	}
+}
+
+func (s *FileStore) Put(ctx context.Context, e Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ids[e.EventID()] {
+		return nil
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := s.f.Write(append(b, '\n')); err != nil {
+		return err
+	}
+
+	s.put(e)
+	return nil
+}
+
+func (s *FileStore) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grezar/go-circleci"
+)
+
+func testEvent(id string, at time.Time) *WorkflowCompletedEvent {
+	return &WorkflowCompletedEvent{Payload: Payload{
+		ID:         id,
+		Type:       circleci.EventWorkflowCompleted,
+		HappenedAt: at,
+		Project:    &circleci.Project{Slug: "gh/org1/prj1"},
+		Workflow:   &Workflow{Workflow: circleci.Workflow{Name: "build", Status: "success"}},
+	}}
+}
+
+func testStore(t *testing.T, s Store) {
+	t.Helper()
+	ctx := context.Background()
+	t0 := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for _, e := range []Event{
+		testEvent("event2", t0.Add(2*time.Minute)),
+		testEvent("event1", t0.Add(time.Minute)),
+		testEvent("event2", t0.Add(2*time.Minute)),
+		testEvent("event0", t0),
+	} {
+		if err := s.Put(ctx, e); err != nil {
+			t.Fatalf("Put got error: %v", err)
+		}
+	}
+
+	if ok, _ := s.Has(ctx, "event1"); !ok {
+		t.Errorf("Has(event1) got false, want true")
+	}
+	if ok, _ := s.Has(ctx, "event3"); ok {
+		t.Errorf("Has(event3) got true, want false")
+	}
+
+	es, err := s.Since(ctx, t0.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("Since got error: %v", err)
+	}
+	want := []Event{
+		testEvent("event1", t0.Add(time.Minute)),
+		testEvent("event2", t0.Add(2*time.Minute)),
+	}
+	if !cmp.Equal(es, want) {
+		t.Errorf("Since got diff (-got +want):\n%s", cmp.Diff(es, want))
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "events.ndjson")
+
+	s, err := OpenFileStore(name)
+	if err != nil {
+		t.Fatalf("OpenFileStore got error: %v", err)
+	}
+	testStore(t, s)
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close got error: %v", err)
+	}
+
+	// Reopening loads the events written before.
+	s, err = OpenFileStore(name)
+	if err != nil {
+		t.Fatalf("OpenFileStore got error: %v", err)
+	}
+	defer s.Close()
+	testStore(t, s)
+}
+
+func TestOpenFileStore_recovers(t *testing.T) {
+	ctx := context.Background()
+	name := filepath.Join(t.TempDir(), "events.ndjson")
+	t0 := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s, err := OpenFileStore(name)
+	if err != nil {
+		t.Fatalf("OpenFileStore got error: %v", err)
+	}
+
+	// Events larger than the buffer of a bufio.Scanner are read back.
+	big := testEvent("event1", t0)
+	big.Pipeline = &Pipeline{TriggerParameters: map[string]interface{}{"blob": strings.Repeat("x", 2*DefaultMaxBodySize)}}
+	if err := s.Put(ctx, big); err != nil {
+		t.Fatalf("Put got error: %v", err)
+	}
+	s.Close()
+
+	// Simulate a crash in the middle of writing the next event.
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(`{"id": "event2", "type": "workflow-compl`); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	s, err = OpenFileStore(name)
+	if err != nil {
+		t.Fatalf("OpenFileStore got error: %v", err)
+	}
+	if ok, _ := s.Has(ctx, "event1"); !ok {
+		t.Errorf("Has(event1) got false, want true")
+	}
+	if err := s.Put(ctx, testEvent("event3", t0)); err != nil {
+		t.Fatalf("Put got error: %v", err)
+	}
+	s.Close()
+
+	s, err = OpenFileStore(name)
+	if err != nil {
+		t.Fatalf("OpenFileStore got error: %v", err)
+	}
+	defer s.Close()
+
+	es, err := s.Since(ctx, t0)
+	if err != nil {
+		t.Fatalf("Since got error: %v", err)
+	}
+	if len(es) != 2 || es[0].EventID() != "event1" || es[1].EventID() != "event3" {
+		t.Errorf("Since got %d events, want event1 and event3", len(es))
+	}
+}
+
+func TestOpenFileStore_corrupt(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "events.ndjson")
+	if err := os.WriteFile(name, []byte("not json\n{\"id\": \"event1\", \"type\": \"workflow-completed\"}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenFileStore(name); err == nil {
+		t.Errorf("OpenFileStore got no error for a corrupt line")
+	}
+}
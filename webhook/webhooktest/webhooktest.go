@@ -0,0 +1,320 @@
+// Package webhooktest generates signed webhook deliveries for testing
+// receivers built with package webhook, or any other receiver.
+package webhooktest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/grezar/go-circleci"
+	"github.com/grezar/go-circleci/webhook"
+)
+
+const (
+	// userAgent and eventTypeHeader are sent along like CircleCI does.
+	userAgent       = "CircleCI-Webhook/1.0"
+	eventTypeHeader = "circleci-event-type"
+)
+
+// EventOptions describes the event to generate. Every field is optional.
+type EventOptions struct {
+	// Type defaults to circleci.EventWorkflowCompleted.
+	Type circleci.Event
+	// Project defaults to gh/example/example.
+	Project string
+	// Branch defaults to main.
+	Branch string
+	// Status is the status of the workflow, or of the job for job-completed
+	// events, whose workflow is still running. It defaults to success.
+	Status string
+	// Workflow defaults to build.
+	Workflow string
+	// Job defaults to test and is only used by job-completed events.
+	Job string
+	// HappenedAt defaults to the current time.
+	HappenedAt time.Time
+}
+
+// NewEvent returns an event shaped like the ones CircleCI sends, with
+// random IDs and revision.
+func NewEvent(options EventOptions) (webhook.Event, error) {
+	o := options
+	if o.Type == "" {
+		o.Type = circleci.EventWorkflowCompleted
+	}
+	if o.Project == "" {
+		o.Project = "gh/example/example"
+	}
+	if o.Branch == "" {
+		o.Branch = "main"
+	}
+	if o.Status == "" {
+		o.Status = "success"
+	}
+	if o.Workflow == "" {
+		o.Workflow = "build"
+	}
+	if o.Job == "" {
+		o.Job = "test"
+	}
+	if o.HappenedAt.IsZero() {
+		o.HappenedAt = time.Now()
+	}
+	o.HappenedAt = o.HappenedAt.UTC().Truncate(time.Millisecond)
+
+	parts := strings.Split(o.Project, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("webhooktest: project slug %q is not of the form vcs/org/repo", o.Project)
+	}
+	provider, org, repo := parts[0], parts[1], parts[2]
+	repoURL := fmt.Sprintf("https://%s/%s/%s", vcsHost(provider), org, repo)
+
+	// Lay out the run backwards from the time the event happened.
+	var (
+		committedAt       = o.HappenedAt.Add(-5 * time.Minute)
+		pipelineCreatedAt = o.HappenedAt.Add(-4 * time.Minute)
+		workflowCreatedAt = pipelineCreatedAt.Add(time.Second)
+		jobStartedAt      = workflowCreatedAt.Add(10 * time.Second)
+		workflowID        = newID()
+		author            = &webhook.Person{Name: "Sample Author", Email: "author@example.com"}
+	)
+
+	p := webhook.Payload{
+		ID:         newID(),
+		Type:       o.Type,
+		HappenedAt: o.HappenedAt,
+		Webhook:    &webhook.WebhookInfo{ID: newID(), Name: "webhooktest"},
+		Project: &circleci.Project{
+			ID:   newID(),
+			Name: repo,
+			Slug: o.Project,
+		},
+		Organization: &circleci.OrganizationDetail{
+			ID:   newID(),
+			Name: org,
+		},
+		Pipeline: &webhook.Pipeline{
+			Pipeline: circleci.Pipeline{
+				ID:        newID(),
+				Number:    1,
+				CreatedAt: pipelineCreatedAt,
+				Trigger:   &circleci.Trigger{Type: "webhook"},
+			},
+			Vcs: &webhook.VCS{
+				VCS: circleci.VCS{
+					ProviderName:        vcsProvider(provider),
+					OriginRepositoryURL: repoURL,
+					TargetRepositoryURL: repoURL,
+					Revision:            newRevision(),
+					Branch:              o.Branch,
+				},
+				Commit: &webhook.Commit{
+					Commit:      circleci.Commit{Subject: "Sample commit"},
+					Author:      author,
+					AuthoredAt:  committedAt,
+					Committer:   author,
+					CommittedAt: committedAt,
+				},
+			},
+		},
+		Workflow: &webhook.Workflow{
+			Workflow: circleci.Workflow{
+				ID:        workflowID,
+				Name:      o.Workflow,
+				Status:    o.Status,
+				CreatedAt: workflowCreatedAt,
+			},
+			StoppedAt: &o.HappenedAt,
+			URL:       "https://app.circleci.com/pipelines/workflows/" + workflowID,
+		},
+	}
+
+	switch o.Type {
+	case circleci.EventWorkflowCompleted:
+		return &webhook.WorkflowCompletedEvent{Payload: p}, nil
+	case circleci.EventJobCompleted:
+		// The workflow is still running when one of its jobs completes.
+		p.Workflow.Status = "running"
+		p.Workflow.StoppedAt = nil
+		return &webhook.JobCompletedEvent{
+			Payload: p,
+			Job: &webhook.Job{
+				Job: circleci.Job{
+					Number:    1,
+					Name:      o.Job,
+					Status:    o.Status,
+					StartedAt: jobStartedAt,
+					StoppedAt: o.HappenedAt,
+				},
+				ID: newID(),
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", webhook.ErrUnknownEventType, o.Type)
+	}
+}
+
+// encode marshals e the way CircleCI delivers it. The event types embed the
+// API's, so the fields deliveries don't carry are left zero and dropped.
+// Trigger parameters are kept as set.
+func encode(e webhook.Event) ([]byte, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(dropZero(v))
+}
+
+func dropZero(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, x := range v {
+			if k == "trigger_parameters" {
+				continue
+			}
+			x = dropZero(x)
+			if isZero(x) {
+				delete(v, k)
+				continue
+			}
+			v[k] = x
+		}
+	case []interface{}:
+		for i, x := range v {
+			v[i] = dropZero(x)
+		}
+	}
+	return v
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == "" || v == "0001-01-01T00:00:00Z"
+	case json.Number:
+		return v == "0"
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// NewRequest returns a POST request to url delivering e signed with secret.
+func NewRequest(ctx context.Context, url string, e webhook.Event, secret string) (*http.Request, error) {
+	body, err := encode(e)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(eventTypeHeader, string(e.EventType()))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(body, secret))
+
+	return req, nil
+}
+
+// Send delivers e signed with secret to url. A nil client defaults to
+// http.DefaultClient. Like CircleCI, it considers any non 2xx response
+// a failed delivery.
+func Send(ctx context.Context, client *http.Client, url string, e webhook.Event, secret string) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := NewRequest(ctx, url, e, secret)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhooktest: delivery failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Serve delivers e signed with secret to h and returns the recorded response.
+func Serve(h http.Handler, e webhook.Event, secret string) (*httptest.ResponseRecorder, error) {
+	req, err := NewRequest(context.Background(), "http://localhost/", e, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	return w, nil
+}
+
+func vcsHost(provider string) string {
+	switch provider {
+	case "bb", "bitbucket":
+		return "bitbucket.org"
+	default:
+		return "github.com"
+	}
+}
+
+func vcsProvider(provider string) string {
+	switch provider {
+	case "bb", "bitbucket":
+		return "Bitbucket"
+	case "gh", "github":
+		return "GitHub"
+	default:
+		return provider
+	}
+}
+
+// newID returns a random UUID, as CircleCI uses for every ID.
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	h := hex.EncodeToString(b)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+func newRevision() string {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,161 @@
+package webhooktest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grezar/go-circleci"
+	"github.com/grezar/go-circleci/webhook"
+)
+
+func TestNewEvent(t *testing.T) {
+	e, err := NewEvent(EventOptions{
+		Type:    circleci.EventJobCompleted,
+		Project: "gh/org1/prj1",
+		Branch:  "feature",
+		Status:  "failed",
+		Job:     "lint",
+	})
+	if err != nil {
+		t.Fatalf("NewEvent got error: %v", err)
+	}
+
+	je, ok := e.(*webhook.JobCompletedEvent)
+	if !ok {
+		t.Fatalf("NewEvent got %T, want *webhook.JobCompletedEvent", e)
+	}
+	if je.Project.Slug != "gh/org1/prj1" || je.Pipeline.Vcs.Branch != "feature" ||
+		je.Job.Name != "lint" || je.Job.Status != "failed" {
+		t.Errorf("NewEvent got unexpected event %+v", je)
+	}
+
+	if _, err := NewEvent(EventOptions{Project: "prj1"}); err == nil {
+		t.Errorf("NewEvent got no error for an invalid project slug")
+	}
+	if _, err := NewEvent(EventOptions{Type: "ping"}); err == nil {
+		t.Errorf("NewEvent got no error for an unknown type")
+	}
+}
+
+func TestNewEvent_timestamps(t *testing.T) {
+	at := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	e, err := NewEvent(EventOptions{Type: circleci.EventJobCompleted, HappenedAt: at})
+	if err != nil {
+		t.Fatalf("NewEvent got error: %v", err)
+	}
+	je := e.(*webhook.JobCompletedEvent)
+
+	// Each step of the run starts after the previous one and the job
+	// stops when the event happens.
+	steps := []struct {
+		name string
+		time time.Time
+	}{
+		{"commit.committed_at", je.Pipeline.Vcs.Commit.CommittedAt},
+		{"pipeline.created_at", je.Pipeline.CreatedAt},
+		{"workflow.created_at", je.Workflow.CreatedAt},
+		{"job.started_at", je.Job.StartedAt},
+		{"job.stopped_at", je.Job.StoppedAt},
+	}
+	for i, step := range steps {
+		if step.time.IsZero() {
+			t.Errorf("NewEvent left %s unset", step.name)
+		}
+		if i > 0 && !step.time.After(steps[i-1].time) {
+			t.Errorf("NewEvent got %s %v, not after %s %v", step.name, step.time, steps[i-1].name, steps[i-1].time)
+		}
+	}
+	if !je.Job.StoppedAt.Equal(at) {
+		t.Errorf("NewEvent got job.stopped_at %v, want %v", je.Job.StoppedAt, at)
+	}
+	if je.Workflow.Status != "running" || je.Workflow.StoppedAt != nil {
+		t.Errorf("NewEvent got workflow %+v, want a running workflow", je.Workflow)
+	}
+
+	b, err := encode(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"0001-01-01", "web_url", "parallel_runs", "messages", "updated_at", "parallelism", "project_slug", "pipeline_id"} {
+		if bytes.Contains(b, []byte(s)) {
+			t.Errorf("NewEvent payload contains %q: %s", s, b)
+		}
+	}
+
+	e, err = NewEvent(EventOptions{HappenedAt: at})
+	if err != nil {
+		t.Fatalf("NewEvent got error: %v", err)
+	}
+	we := e.(*webhook.WorkflowCompletedEvent)
+	if we.Workflow.StoppedAt == nil || !we.Workflow.StoppedAt.Equal(at) {
+		t.Errorf("NewEvent got workflow.stopped_at %v, want %v", we.Workflow.StoppedAt, at)
+	}
+}
+
+func TestServe(t *testing.T) {
+	e, err := NewEvent(EventOptions{})
+	if err != nil {
+		t.Fatalf("NewEvent got error: %v", err)
+	}
+
+	var got webhook.Event
+	h, err := webhook.NewHandler(webhook.HandlerOptions{Secrets: []string{"secret"}}, func(ctx context.Context, e webhook.Event) error {
+		got = e
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewHandler got error: %v", err)
+	}
+
+	w, err := Serve(h, e, "secret")
+	if err != nil {
+		t.Fatalf("Serve got error: %v", err)
+	}
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Serve got status %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+
+	// The generated event survives the round trip unchanged.
+	if !cmp.Equal(got, e) {
+		t.Errorf("handler got diff (-got +want):\n%s", cmp.Diff(got, e))
+	}
+
+	w, err = Serve(h, e, "other")
+	if err != nil {
+		t.Fatalf("Serve got error: %v", err)
+	}
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Serve got status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestSend(t *testing.T) {
+	e, err := NewEvent(EventOptions{HappenedAt: time.Now().Add(-time.Hour)})
+	if err != nil {
+		t.Fatalf("NewEvent got error: %v", err)
+	}
+
+	h, err := webhook.NewHandler(webhook.HandlerOptions{Secrets: []string{"secret"}}, func(ctx context.Context, e webhook.Event) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewHandler got error: %v", err)
+	}
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	// The handler rejects events older than its tolerance.
+	if err := Send(context.Background(), nil, server.URL, e, "secret"); err == nil {
+		t.Errorf("Send got no error, want one")
+	}
+
+	e, _ = NewEvent(EventOptions{})
+	if err := Send(context.Background(), nil, server.URL, e, "secret"); err != nil {
+		t.Errorf("Send got error: %v", err)
+	}
+}
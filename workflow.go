@@ -131,6 +131,7 @@ type WorkflowRerunOptions struct {
 	Jobs       []*string `json:"jobs,omitempty"`
 	FromFailed *bool     `json:"from_failed,omitempty"`
 	SparseTree *bool     `json:"sparse_tree,omitempty"`
+	EnableSSH  *bool     `json:"enable_ssh,omitempty"`
 }
 
 func (o WorkflowRerunOptions) valid() error {